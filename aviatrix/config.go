@@ -14,12 +14,13 @@ import (
 // Config contains the configuration for the Aviatrix provider
 // (Username, Password, and Controller IP)
 type Config struct {
-	Username     string
-	Password     string
-	ControllerIP string
-	VerifyCert   bool
-	PathToCACert string
-	IgnoreTags   *goaviatrix.IgnoreTagsConfig
+	Username            string
+	Password            string
+	ControllerIP        string
+	VerifyCert          bool
+	PathToCACert        string
+	IgnoreTags          *goaviatrix.IgnoreTagsConfig
+	EnforceAccountAudit bool
 }
 
 // Client gets the Aviatrix client to access the Controller
@@ -52,6 +53,8 @@ func (c *Config) Client() (*goaviatrix.Client, error) {
 
 	if client == nil || err != nil {
 		log.Printf("[ERROR] unable to create client: %s", err)
+	} else {
+		client.EnforceAccountAudit = c.EnforceAccountAudit
 	}
 	return client, err
 }
@@ -0,0 +1,132 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixAccountAudit() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAccountAuditRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the audit result of this account.",
+			},
+			"account_audits": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of account audit results.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Account name.",
+						},
+						"cloud_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Type of cloud service provider.",
+						},
+						"passed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the account passed the audit.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit status.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Details of the audit failure.",
+						},
+						"iam_role_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit status of the IAM roles of the account.",
+						},
+						"iam_policy_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit status of the IAM policies of the account.",
+						},
+						"missing_permissions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Permissions missing from the IAM policies of the account.",
+						},
+						"last_audit_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last audit.",
+						},
+					},
+				},
+			},
+			"failed_account_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the returned accounts that failed the audit.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAccountAuditRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	accountName := d.Get("account_name").(string)
+
+	accountAuditResults, err := client.ListAccountAuditResults(ctx)
+	if err != nil {
+		return diag.Errorf("could not list account audit results: %v", err)
+	}
+
+	var accountAudits []map[string]interface{}
+	var failedAccountNames []string
+	for _, accountAuditResult := range accountAuditResults {
+		if accountName != "" && accountAuditResult.AccountName != accountName {
+			continue
+		}
+		accountAudits = append(accountAudits, map[string]interface{}{
+			"account_name":        accountAuditResult.AccountName,
+			"cloud_type":          accountAuditResult.CloudType,
+			"passed":              accountAuditResult.Passed(),
+			"status":              accountAuditResult.Status,
+			"comment":             accountAuditResult.Comment,
+			"iam_role_status":     accountAuditResult.RoleStatus,
+			"iam_policy_status":   accountAuditResult.PolicyStatus,
+			"missing_permissions": accountAuditResult.MissingPermissions,
+			"last_audit_time":     accountAuditResult.AuditTime,
+		})
+		if !accountAuditResult.Passed() {
+			failedAccountNames = append(failedAccountNames, accountAuditResult.AccountName)
+		}
+	}
+
+	if accountName != "" && len(accountAudits) == 0 {
+		return diag.Errorf("could not find audit result of account %s", accountName)
+	}
+
+	if err := d.Set("account_audits", accountAudits); err != nil {
+		return diag.Errorf("couldn't set account_audits: %v", err)
+	}
+	if err := d.Set("failed_account_names", failedAccountNames); err != nil {
+		return diag.Errorf("couldn't set failed_account_names: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("account_audit~%s", accountName))
+	return nil
+}
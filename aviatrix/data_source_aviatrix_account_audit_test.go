@@ -0,0 +1,69 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixAccountAudit_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_account_audit.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_ACCOUNT_AUDIT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Account Audit tests as SKIP_DATA_ACCOUNT_AUDIT is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, ". Set SKIP_DATA_ACCOUNT_AUDIT to yes to skip Data Source Account Audit tests")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAccountAuditConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixAccountAudit(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_audits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_audits.0.account_name", fmt.Sprintf("tfa-%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "account_audits.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAccountAuditConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+	audit_account      = true
+}
+
+data "aviatrix_account_audit" "foo" {
+	account_name = aviatrix_account.test.account_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"))
+}
+
+func testAccDataSourceAviatrixAccountAudit(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
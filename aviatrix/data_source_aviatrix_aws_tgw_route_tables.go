@@ -0,0 +1,144 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixAwsTgwRouteTables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAwsTgwRouteTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS TGW name.",
+			},
+			"network_domain_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the route table of this Network Domain.",
+			},
+			"route_tables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of TGW route tables, one per Network Domain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_domain_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Network Domain name.",
+						},
+						"route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "TGW route table ID.",
+						},
+						"associations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of attachments associated with the route table.",
+						},
+						"propagations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of attachments propagating routes into the route table.",
+						},
+						"routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of routes in the route table.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Destination CIDR.",
+									},
+									"attachment_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "List of TGW attachment IDs the route points to.",
+									},
+									"vpc_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "List of VPC IDs the route points to.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Route type. Either 'static' or 'propagated'.",
+									},
+									"blackhole": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the route is a blackhole route.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAwsTgwRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	tgwName := d.Get("tgw_name").(string)
+	domainName := d.Get("network_domain_name").(string)
+
+	routeDomains, err := client.GetAwsTgwRouteTables(ctx, tgwName)
+	if err != nil {
+		return diag.Errorf("could not get route tables for AWS TGW %s: %v", tgwName, err)
+	}
+
+	var routeTables []map[string]interface{}
+	for _, routeDomain := range routeDomains {
+		if domainName != "" && routeDomain.Name != domainName {
+			continue
+		}
+
+		var routes []map[string]interface{}
+		for _, route := range routeDomain.RoutesInRouteTable {
+			routes = append(routes, map[string]interface{}{
+				"cidr":           route.CidrBlock,
+				"attachment_ids": route.TgwAttachmentId,
+				"vpc_ids":        route.VPCId,
+				"type":           route.Type,
+				"blackhole":      route.State == "blackhole",
+			})
+		}
+
+		routeTables = append(routeTables, map[string]interface{}{
+			"network_domain_name": routeDomain.Name,
+			"route_table_id":      routeDomain.RouteTableId,
+			"associations":        routeDomain.Associations,
+			"propagations":        routeDomain.Propagations,
+			"routes":              routes,
+		})
+	}
+
+	if domainName != "" && len(routeTables) == 0 {
+		return diag.Errorf("could not find Network Domain %s on AWS TGW %s", domainName, tgwName)
+	}
+
+	if err = d.Set("route_tables", routeTables); err != nil {
+		return diag.Errorf("couldn't set route_tables: %v", err)
+	}
+
+	d.SetId(tgwName)
+	return nil
+}
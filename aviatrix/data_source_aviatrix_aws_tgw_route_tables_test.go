@@ -0,0 +1,90 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAviatrixAwsTgwRouteTables_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	charset := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	tgwName := acctest.RandStringFromCharSet(5, charset) + acctest.RandString(5)
+	ndName := acctest.RandStringFromCharSet(5, charset) + acctest.RandString(5)
+	resourceName := "data.aviatrix_aws_tgw_route_tables.test"
+
+	skipAcc := os.Getenv("SKIP_DATA_AWS_TGW_ROUTE_TABLES")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source AWS TGW Route Tables tests as SKIP_DATA_AWS_TGW_ROUTE_TABLES is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixAwsTgwRouteTablesConfigBasic(rName, tgwName, ndName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsTgwNetworkDomainExists("aviatrix_aws_tgw_network_domain.test", tgwName, ndName),
+					resource.TestCheckResourceAttr(resourceName, "tgw_name", tgwName),
+					resource.TestCheckResourceAttr(resourceName, "route_tables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route_tables.0.network_domain_name", ndName),
+					resource.TestCheckResourceAttrSet(resourceName, "route_tables.0.route_table_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixAwsTgwRouteTablesConfigBasic(rName string, tgwName string, ndName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_aws_tgw" "test" {
+	account_name                      = aviatrix_account.test.account_name
+	aws_side_as_number                = "64512"
+	region                            = "us-west-1"
+	tgw_name                          = "%s"
+	manage_security_domain            = false
+	manage_vpc_attachment             = false
+	manage_transit_gateway_attachment = false
+}
+resource "aviatrix_aws_tgw_network_domain" "Default_Domain" {
+	name     = "Default_Domain"
+	tgw_name = aviatrix_aws_tgw.test.tgw_name
+}
+resource "aviatrix_aws_tgw_network_domain" "Shared_Service_Domain" {
+	name     = "Shared_Service_Domain"
+	tgw_name = aviatrix_aws_tgw.test.tgw_name
+}
+resource "aviatrix_aws_tgw_network_domain" "Aviatrix_Edge_Domain" {
+	name     = "Aviatrix_Edge_Domain"
+	tgw_name = aviatrix_aws_tgw.test.tgw_name
+}
+resource "aviatrix_aws_tgw_network_domain" "test" {
+	name       = "%s"
+	tgw_name   = aviatrix_aws_tgw.test.tgw_name
+	depends_on = [
+		aviatrix_aws_tgw_network_domain.Default_Domain,
+		aviatrix_aws_tgw_network_domain.Shared_Service_Domain,
+		aviatrix_aws_tgw_network_domain.Aviatrix_Edge_Domain
+	]
+}
+data "aviatrix_aws_tgw_route_tables" "test" {
+	tgw_name            = aviatrix_aws_tgw.test.tgw_name
+	network_domain_name = aviatrix_aws_tgw_network_domain.test.name
+}
+`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		tgwName, ndName)
+}
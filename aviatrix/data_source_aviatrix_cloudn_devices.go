@@ -0,0 +1,143 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixCloudnDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixCloudnDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of CloudN devices registered with the controller.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the device.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address or FQDN of the device.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username used to register the device.",
+						},
+						"host_os": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Host OS of the device.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the device.",
+						},
+						"is_caag": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the device is registered as a CloudN as a Gateway.",
+						},
+						"registration_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Registration state of the device.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the device.",
+						},
+						"check_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Reason of the last failed health check.",
+						},
+						"software_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Software version of the device.",
+						},
+						"upgrade_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the last software upgrade of the device.",
+						},
+						"wan_primary_interface": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "WAN primary interface of the device.",
+						},
+						"wan_primary_interface_public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "WAN primary interface public IP address.",
+						},
+						"connection_names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the connections of the device.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixCloudnDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	devices, err := client.ListDevices(ctx)
+	if err != nil {
+		return diag.Errorf("could not list CloudN devices: %v", err)
+	}
+
+	var result []map[string]interface{}
+	for _, device := range devices {
+		var connectionNames []string
+		// ConnectionName is actually a CSV list of connection names
+		for _, connName := range strings.Split(device.ConnectionName, ",") {
+			if connName != "" {
+				connectionNames = append(connectionNames, connName)
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":                            device.Name,
+			"address":                         device.PublicIP,
+			"username":                        device.Username,
+			"host_os":                         device.HostOS,
+			"description":                     device.Description,
+			"is_caag":                         device.IsCaag,
+			"registration_state":              device.RegistrationState,
+			"status":                          device.Status,
+			"check_reason":                    device.CheckReason,
+			"software_version":                device.SoftwareVersion,
+			"upgrade_status":                  device.UpgradeStatus,
+			"wan_primary_interface":           device.PrimaryInterface,
+			"wan_primary_interface_public_ip": device.PrimaryInterfaceIP,
+			"connection_names":                connectionNames,
+		})
+	}
+
+	if err := d.Set("devices", result); err != nil {
+		return diag.Errorf("couldn't set devices: %v", err)
+	}
+
+	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	return nil
+}
@@ -0,0 +1,54 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixCloudnDevices_basic(t *testing.T) {
+	resourceName := "data.aviatrix_cloudn_devices.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_CLOUDN_DEVICES")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source CloudN Devices tests as SKIP_DATA_CLOUDN_DEVICES is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCloudnDevicesConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixCloudnDevices(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "devices.*", map[string]string{
+						"name": os.Getenv("CLOUDN_DEVICE_NAME"),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCloudnDevicesConfigBasic() string {
+	return `
+data "aviatrix_cloudn_devices" "foo" {}
+	`
+}
+
+func testAccDataSourceAviatrixCloudnDevices(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixConnectionStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixConnectionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"connection_name"},
+				ConflictsWith: []string{"transit_gateway_name1", "transit_gateway_name2"},
+				Description:   "VPC ID of the site2cloud or external device connection.",
+			},
+			"connection_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"vpc_id"},
+				ExactlyOneOf: []string{"connection_name", "transit_gateway_name1"},
+				Description:  "Name of the site2cloud or external device connection.",
+			},
+			"transit_gateway_name1": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"transit_gateway_name2"},
+				Description:  "Name of the first transit gateway of a transit gateway peering.",
+			},
+			"transit_gateway_name2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"transit_gateway_name1"},
+				Description:  "Name of the second transit gateway of a transit gateway peering.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Overall status of the connection.",
+			},
+			"tunnels": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of tunnels of the connection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the gateway terminating the tunnel.",
+						},
+						"peer_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the tunnel peer.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Up/down state of the tunnel.",
+						},
+						"ike_sa_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the IKE security association.",
+						},
+						"ipsec_sa_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the IPsec security association.",
+						},
+						"bgp_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "BGP neighbor state of the tunnel.",
+						},
+						"uptime": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Uptime of the tunnel.",
+						},
+						"bytes_in": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of bytes received over the tunnel.",
+						},
+						"bytes_out": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of bytes sent over the tunnel.",
+						},
+						"last_flap_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last tunnel state change.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixConnectionStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	var status *goaviatrix.ConnectionStatus
+	var id string
+	var err error
+	if connName, ok := d.GetOk("connection_name"); ok {
+		vpcID := d.Get("vpc_id").(string)
+		status, err = client.GetSite2CloudConnStatus(ctx, vpcID, connName.(string))
+		id = connName.(string) + "~" + vpcID
+	} else {
+		gwName1 := d.Get("transit_gateway_name1").(string)
+		gwName2 := d.Get("transit_gateway_name2").(string)
+		status, err = client.GetTransitGatewayPeeringStatus(ctx, gwName1, gwName2)
+		id = gwName1 + "~" + gwName2
+	}
+	if err != nil {
+		return diag.Errorf("could not get connection status: %v", err)
+	}
+
+	var tunnels []map[string]interface{}
+	for _, tunnel := range status.Tunnels {
+		t := map[string]interface{}{
+			"gw_name":         tunnel.GwName,
+			"peer_ip":         tunnel.PeerIP,
+			"status":          tunnel.Status,
+			"ike_sa_status":   tunnel.IkeSaStatus,
+			"ipsec_sa_status": tunnel.IpsecSaStatus,
+			"bgp_status":      tunnel.BgpStatus,
+			"uptime":          tunnel.Uptime,
+			"bytes_in":        tunnel.BytesIn,
+			"bytes_out":       tunnel.BytesOut,
+			"last_flap_time":  tunnel.LastFlapTime,
+		}
+		tunnels = append(tunnels, t)
+	}
+
+	d.Set("status", status.Status)
+	if err := d.Set("tunnels", tunnels); err != nil {
+		return diag.Errorf("failed to set tunnels: %v", err)
+	}
+
+	d.SetId(id)
+	return nil
+}
@@ -0,0 +1,89 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixConnectionStatus_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_connection_status.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_CONNECTION_STATUS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Connection Status test as SKIP_DATA_CONNECTION_STATUS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, ". Set SKIP_DATA_CONNECTION_STATUS to yes to skip Data Source Connection Status tests")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnectionStatusConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceConnectionStatus(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", fmt.Sprintf("tfs-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "vpc_id", os.Getenv("AWS_VPC_ID")),
+					resource.TestCheckResourceAttr(resourceName, "tunnels.0.gw_name", fmt.Sprintf("tfg-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tunnels.0.peer_ip", "8.8.8.8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnectionStatusConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+}
+resource "aviatrix_site2cloud" "test" {
+	vpc_id                     = aviatrix_gateway.test.vpc_id
+	connection_name            = "tfs-%[1]s"
+	connection_type            = "unmapped"
+	remote_gateway_type        = "generic"
+	tunnel_type                = "policy"
+	primary_cloud_gateway_name = aviatrix_gateway.test.gw_name
+	remote_gateway_ip          = "8.8.8.8"
+	remote_subnet_cidr         = "10.23.0.0/24"
+}
+data "aviatrix_connection_status" "foo" {
+	vpc_id          = aviatrix_site2cloud.test.vpc_id
+	connection_name = aviatrix_site2cloud.test.connection_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceConnectionStatus(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
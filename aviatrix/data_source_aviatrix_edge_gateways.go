@@ -0,0 +1,153 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixEdgeGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixEdgeGatewaysRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return Edge gateways of this type. Valid values: \"spoke\", \"caag\", \"csp\".",
+				ValidateFunc: validation.StringInSlice([]string{"spoke", "caag", "csp"}, false),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return Edge gateways in this state.",
+			},
+			"gateway_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all Edge gateways.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Edge gateway name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Edge gateway type: \"spoke\", \"caag\" or \"csp\".",
+						},
+						"cloud_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Type of cloud service provider.",
+						},
+						"site_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Site ID.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the Edge gateway, e.g. \"waiting\" while ZTP is pending, \"up\" or \"down\".",
+						},
+						"primary_gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the primary Edge gateway. Only set for HA gateways.",
+						},
+						"wan_public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "WAN public IP.",
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Private IP.",
+						},
+						"software_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Software version of the Edge gateway.",
+						},
+						"latitude": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The latitude of the Edge gateway.",
+						},
+						"longitude": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The longitude of the Edge gateway.",
+						},
+						"attached": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the Edge gateway is attached to a transit gateway.",
+						},
+						"transit_gw_names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the transit gateways the Edge gateway is attached to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixEdgeGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwType := d.Get("type").(string)
+	state := d.Get("state").(string)
+
+	edgeGatewayList, err := client.ListEdgeGateways(ctx)
+	if err != nil {
+		return diag.Errorf("could not get Aviatrix Edge Gateway List: %s", err)
+	}
+
+	var result []map[string]interface{}
+	for i := range edgeGatewayList {
+		gw := &edgeGatewayList[i]
+		if (gwType != "" && gw.EdgeGatewayType() != gwType) || (state != "" && gw.State != state) {
+			continue
+		}
+
+		edgeGateway := map[string]interface{}{
+			"gw_name":          gw.GwName,
+			"type":             gw.EdgeGatewayType(),
+			"cloud_type":       gw.CloudType,
+			"site_id":          gw.SiteId,
+			"state":            gw.State,
+			"primary_gw_name":  gw.PrimaryGwName,
+			"wan_public_ip":    gw.WanPublicIp,
+			"private_ip":       gw.PrivateIP,
+			"software_version": gw.SoftwareVersion,
+			"attached":         len(gw.TransitGwNames()) != 0,
+			"transit_gw_names": gw.TransitGwNames(),
+		}
+		if gw.LatitudeReturn != 0 || gw.LongitudeReturn != 0 {
+			edgeGateway["latitude"] = fmt.Sprintf("%.6f", gw.LatitudeReturn)
+			edgeGateway["longitude"] = fmt.Sprintf("%.6f", gw.LongitudeReturn)
+		}
+
+		result = append(result, edgeGateway)
+	}
+
+	if err = d.Set("gateway_list", result); err != nil {
+		return diag.Errorf("couldn't set gateway_list: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("edge_gateways~%s~%s", gwType, state))
+	return nil
+}
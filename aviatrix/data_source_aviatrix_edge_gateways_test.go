@@ -0,0 +1,72 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixEdgeGateways_basic(t *testing.T) {
+	resourceName := "data.aviatrix_edge_gateways.foo"
+	gwName := "edge-" + acctest.RandString(5)
+	siteId := "site-" + acctest.RandString(5)
+	path, _ := os.Getwd()
+
+	skipAcc := os.Getenv("SKIP_DATA_EDGE_GATEWAYS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Edge Gateways tests as SKIP_DATA_EDGE_GATEWAYS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEdgeGatewaysConfigBasic(gwName, siteId, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixEdgeGateways(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gateway_list.0.gw_name", gwName),
+					resource.TestCheckResourceAttr(resourceName, "gateway_list.0.site_id", siteId),
+					resource.TestCheckResourceAttr(resourceName, "gateway_list.0.type", "spoke"),
+					resource.TestCheckResourceAttr(resourceName, "gateway_list.0.attached", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEdgeGatewaysConfigBasic(gwName, siteId, path string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_edge_spoke" "test" {
+	gw_name                     = "%s"
+	site_id                     = "%s"
+	management_interface_config = "DHCP"
+	wan_interface_ip_prefix     = "10.60.0.0/24"
+	wan_default_gateway_ip      = "10.60.0.0"
+	lan_interface_ip_prefix     = "10.60.0.0/24"
+	ztp_file_type               = "iso"
+	ztp_file_download_path      = "%s"
+}
+data "aviatrix_edge_gateways" "foo" {
+	type  = "spoke"
+	state = aviatrix_edge_spoke.test.state
+}
+	`, gwName, siteId, path)
+}
+
+func testAccDataSourceAviatrixEdgeGateways(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,144 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixFireNetHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFireNetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "VPC ID of the FireNet.",
+			},
+			"inspection_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether traffic inspection is enabled.",
+			},
+			"egress_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether egress through the firewalls is enabled.",
+			},
+			"hashing_algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hashing algorithm used to distribute flows across the firewalls.",
+			},
+			"keep_alive_via_lan_interface_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether firewall health is checked via the LAN interface.",
+			},
+			"tgw_segmentation_for_egress_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether TGW segmentation is enabled for egress.",
+			},
+			"firewalls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of firewalls associated with the FireNet.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the firewall instance, or the FQDN gateway's gw_name.",
+						},
+						"firewall_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Firewall instance name.",
+						},
+						"firenet_gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the FireNet gateway the firewall is associated with.",
+						},
+						"vendor_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Either 'Generic' or 'fqdn_gateway'.",
+						},
+						"attached": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the firewall is attached and receiving traffic.",
+						},
+						"lan_keepalive_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the LAN interface keepalive.",
+						},
+						"health_check": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Result of the firewall health check.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixFireNetHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpcID := d.Get("vpc_id").(string)
+
+	fireNetDetail, err := client.GetFireNet(&goaviatrix.FireNet{VpcID: vpcID})
+	if err != nil {
+		return diag.Errorf("couldn't find FireNet %s: %v", vpcID, err)
+	}
+
+	health, err := client.GetFireNetFirewallHealth(ctx, vpcID)
+	if err != nil {
+		return diag.Errorf("couldn't get health status of FireNet %s: %v", vpcID, err)
+	}
+	healthByInstance := make(map[string]goaviatrix.FireNetFirewallHealth)
+	for _, h := range health {
+		healthByInstance[h.InstanceID] = h
+	}
+
+	d.Set("inspection_enabled", fireNetDetail.Inspection == "yes")
+	d.Set("egress_enabled", fireNetDetail.FirewallEgress == "yes")
+	d.Set("hashing_algorithm", fireNetDetail.HashingAlgorithm)
+	d.Set("keep_alive_via_lan_interface_enabled", fireNetDetail.LanPing == "yes")
+	d.Set("tgw_segmentation_for_egress_enabled", fireNetDetail.TgwSegmentationForEgress == "yes")
+
+	var firewalls []map[string]interface{}
+	for _, instance := range fireNetDetail.FirewallInstance {
+		fw := map[string]interface{}{
+			"instance_id":          instance.InstanceID,
+			"firenet_gw_name":      instance.GwName,
+			"attached":             instance.Enabled,
+			"lan_keepalive_status": healthByInstance[instance.InstanceID].LanKeepAlive,
+			"health_check":         healthByInstance[instance.InstanceID].HealthCheck,
+		}
+		if instance.VendorType == "Aviatrix FQDN Gateway" {
+			fw["vendor_type"] = "fqdn_gateway"
+			fw["firewall_name"] = ""
+		} else {
+			fw["vendor_type"] = "Generic"
+			fw["firewall_name"] = instance.FirewallName
+		}
+		firewalls = append(firewalls, fw)
+	}
+
+	if err := d.Set("firewalls", firewalls); err != nil {
+		return diag.Errorf("couldn't set firewalls: %v", err)
+	}
+
+	d.SetId(vpcID)
+	return nil
+}
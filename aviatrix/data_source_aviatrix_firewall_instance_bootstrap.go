@@ -0,0 +1,235 @@
+package aviatrix
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixFirewallInstanceBootstrap() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFirewallInstanceBootstrapRead,
+
+		Schema: map[string]*schema.Schema{
+			"firewall_image": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Firewall image of the aviatrix_firewall_instance the bundle is rendered for.",
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hostname of the firewall.",
+			},
+			"admin_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "admin",
+				Description: "Admin username. Not applicable to Check Point.",
+			},
+			"admin_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Admin password. Applicable to Fortinet FortiGate and Check Point only.",
+			},
+			"admin_password_hash": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Admin password hash. Applicable to Palo Alto Networks VM-Series only.",
+			},
+			"ssh_public_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Admin SSH public key. Applicable to Palo Alto Networks VM-Series and Fortinet FortiGate only.",
+			},
+			"lan_interface_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the firewall interface attached to 'lan_interface'.",
+			},
+			"egress_interface_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the firewall interface attached to 'egress_interface'.",
+			},
+			"lan_gateway_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  "Gateway IP of the LAN subnet. Required if 'lan_routes' is set.",
+			},
+			"lan_routes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+				Description: "List of CIDRs routed through the LAN interface.",
+			},
+			"allow_all_traffic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Render a security policy allowing all traffic.",
+			},
+			"license_auth_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of license auth codes. Applicable to Palo Alto Networks VM-Series only.",
+			},
+			"sic_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secure Internal Communication key. Required for Check Point.",
+			},
+			"panorama": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Panorama settings. Applicable to Palo Alto Networks VM-Series only.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Primary Panorama server.",
+						},
+						"server2": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Secondary Panorama server.",
+						},
+						"template_stack": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Panorama template stack name.",
+						},
+						"device_group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Panorama device group name.",
+						},
+						"vm_auth_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Panorama VM auth key.",
+						},
+					},
+				},
+			},
+			"fortimanager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "FortiManager settings. Applicable to Fortinet FortiGate only.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "FortiManager IP address or FQDN.",
+						},
+						"serial_number": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "FortiManager serial number.",
+						},
+					},
+				},
+			},
+			"vendor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Firewall vendor.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Bootstrap files keyed by their path in the bootstrap bucket or file share.",
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "User data to pass to the 'user_data' attribute of aviatrix_firewall_instance.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixFirewallInstanceBootstrapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := &goaviatrix.FirewallBootstrapConfig{
+		FirewallImage:       d.Get("firewall_image").(string),
+		Hostname:            d.Get("hostname").(string),
+		AdminUsername:       d.Get("admin_username").(string),
+		AdminPassword:       d.Get("admin_password").(string),
+		AdminPasswordHash:   d.Get("admin_password_hash").(string),
+		SshPublicKey:        d.Get("ssh_public_key").(string),
+		LanInterfaceName:    d.Get("lan_interface_name").(string),
+		EgressInterfaceName: d.Get("egress_interface_name").(string),
+		LanGatewayIP:        d.Get("lan_gateway_ip").(string),
+		LanRoutes:           goaviatrix.ExpandStringList(d.Get("lan_routes").([]interface{})),
+		AllowAllTraffic:     d.Get("allow_all_traffic").(bool),
+		LicenseAuthCodes:    goaviatrix.ExpandStringList(d.Get("license_auth_codes").([]interface{})),
+		SicKey:              d.Get("sic_key").(string),
+	}
+
+	if v, ok := d.GetOk("panorama"); ok {
+		panorama := v.([]interface{})[0].(map[string]interface{})
+		config.Panorama = &goaviatrix.PanoramaBootstrapConfig{
+			Server:        panorama["server"].(string),
+			Server2:       panorama["server2"].(string),
+			TemplateStack: panorama["template_stack"].(string),
+			DeviceGroup:   panorama["device_group"].(string),
+			VmAuthKey:     panorama["vm_auth_key"].(string),
+		}
+	}
+	if v, ok := d.GetOk("fortimanager"); ok {
+		fortiManager := v.([]interface{})[0].(map[string]interface{})
+		config.FortiManager = &goaviatrix.FortiManagerBootstrapConfig{
+			IP:           fortiManager["ip"].(string),
+			SerialNumber: fortiManager["serial_number"].(string),
+		}
+	}
+
+	bundle, err := goaviatrix.RenderFirewallBootstrap(config)
+	if err != nil {
+		return diag.Errorf("could not render firewall bootstrap bundle: %v", err)
+	}
+
+	d.Set("vendor", bundle.Vendor)
+	d.Set("lan_interface_name", bundle.LanInterfaceName)
+	d.Set("egress_interface_name", bundle.EgressInterfaceName)
+	d.Set("user_data", bundle.UserData)
+	if err := d.Set("files", bundle.Files); err != nil {
+		return diag.Errorf("could not set files: %v", err)
+	}
+
+	var paths []string
+	for path := range bundle.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	h := sha256.New()
+	for _, path := range paths {
+		h.Write([]byte(path))
+		h.Write([]byte(bundle.Files[path]))
+	}
+	h.Write([]byte(bundle.UserData))
+	d.SetId(fmt.Sprintf("%x", h.Sum(nil)))
+	return nil
+}
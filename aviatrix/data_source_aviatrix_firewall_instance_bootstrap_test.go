@@ -0,0 +1,57 @@
+package aviatrix
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAviatrixFirewallInstanceBootstrap_basic(t *testing.T) {
+	resourceName := "data.aviatrix_firewall_instance_bootstrap.test"
+
+	skipAcc := os.Getenv("SKIP_DATA_FIREWALL_INSTANCE_BOOTSTRAP")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source Firewall Instance Bootstrap tests as SKIP_DATA_FIREWALL_INSTANCE_BOOTSTRAP is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAviatrixFirewallInstanceBootstrapConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vendor", "Palo Alto Networks"),
+					resource.TestCheckResourceAttr(resourceName, "lan_interface_name", "ethernet1/2"),
+					resource.TestCheckResourceAttr(resourceName, "egress_interface_name", "ethernet1/1"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.config/init-cfg.txt"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.config/bootstrap.xml"),
+					resource.TestCheckResourceAttr(resourceName, "files.license/authcodes", "I1234567\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAviatrixFirewallInstanceBootstrapConfigBasic() string {
+	return `
+data "aviatrix_firewall_instance_bootstrap" "test" {
+	firewall_image     = "Palo Alto Networks VM-Series Next-Generation Firewall Bundle 1"
+	hostname           = "firenet-fw-1"
+	lan_gateway_ip     = "10.10.0.1"
+	lan_routes         = ["10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"]
+	allow_all_traffic  = true
+	license_auth_codes = ["I1234567"]
+
+	panorama {
+		server         = "10.20.0.10"
+		template_stack = "firenet-stack"
+		device_group   = "firenet-dg"
+	}
+}
+`
+}
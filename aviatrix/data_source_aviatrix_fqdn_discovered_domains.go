@@ -0,0 +1,90 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixFQDNDiscoveredDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFQDNDiscoveredDomainsRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the gateway FQDN discovery runs on.",
+			},
+			"discovered_domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of domains discovered on the gateway.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "FQDN.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protocol.",
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Port.",
+						},
+						"hit_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of times the domain was reached.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixFQDNDiscoveredDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	domains, err := client.GetFQDNDiscoveredDomains(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("could not get FQDN discovery results of gateway %s: %v", gwName, err)
+	}
+
+	sort.SliceStable(domains, func(i, j int) bool {
+		if domains[i].FQDN != domains[j].FQDN {
+			return domains[i].FQDN < domains[j].FQDN
+		}
+		if domains[i].Protocol != domains[j].Protocol {
+			return domains[i].Protocol < domains[j].Protocol
+		}
+		return domains[i].Port < domains[j].Port
+	})
+
+	var discoveredDomains []map[string]interface{}
+	for _, domain := range domains {
+		discoveredDomains = append(discoveredDomains, map[string]interface{}{
+			"fqdn":      domain.FQDN,
+			"protocol":  domain.Protocol,
+			"port":      domain.Port,
+			"hit_count": domain.HitCount,
+		})
+	}
+
+	if err := d.Set("discovered_domains", discoveredDomains); err != nil {
+		return diag.Errorf("couldn't set discovered_domains: %v", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
@@ -0,0 +1,96 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixFQDNDiscoveredDomains_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_fqdn_discovered_domains.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_FQDN_DISCOVERED_DOMAINS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source FQDN Discovered Domains tests as SKIP_DATA_FQDN_DISCOVERED_DOMAINS is set")
+	}
+	msg := ". Set SKIP_DATA_FQDN_DISCOVERED_DOMAINS to yes to skip Data Source FQDN Discovered Domains tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFQDNDiscoveredDomainsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixFQDNDiscoveredDomains(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfg-aws-%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "discovered_domains.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFQDNDiscoveredDomainsConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_acc_aws" {
+	account_name       = "tf-acc-aws-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+
+resource "aviatrix_vpc" "test_vpc" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_acc_aws.account_name
+	region       = "%[5]s"
+	name         = "aws-vpc-%[1]s"
+	cidr         = "10.0.10.0/24"
+}
+
+data "aviatrix_vpc" "test_vpc" {
+	name = aviatrix_vpc.test_vpc.name
+}
+
+resource "aviatrix_gateway" "test_gw_aws" {
+	cloud_type     = 1
+	account_name   = aviatrix_account.test_acc_aws.account_name
+	gw_name        = "tfg-aws-%[1]s"
+	vpc_id         = aviatrix_vpc.test_vpc.vpc_id
+	vpc_reg        = "%[5]s"
+	gw_size        = "t2.micro"
+	subnet         = data.aviatrix_vpc.test_vpc.public_subnets[0].cidr
+	single_ip_snat = true
+}
+
+resource "aviatrix_fqdn_discovery" "test" {
+	gw_name = aviatrix_gateway.test_gw_aws.gw_name
+}
+
+data "aviatrix_fqdn_discovered_domains" "foo" {
+	gw_name = aviatrix_fqdn_discovery.test.gw_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_REGION"))
+}
+
+func testAccDataSourceAviatrixFQDNDiscoveredDomains(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,240 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAviatrixFQDNStats() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFQDNStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"fqdn_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return statistics of this FQDN tag.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return statistics of this gateway.",
+			},
+			"time_window_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of hours, counting back from now, to return statistics for.",
+			},
+			"rule_stats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hit counts of each FQDN tag rule on each gateway the tag is attached to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "FQDN tag name.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Gateway name.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "FQDN.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protocol.",
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Port.",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Rule action.",
+						},
+						"hit_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of requests that matched the rule in the time window.",
+						},
+						"last_hit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last request that matched the rule.",
+						},
+					},
+				},
+			},
+			"denied_domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Domains denied on each gateway in the time window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Gateway name.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Denied FQDN.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protocol.",
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Port.",
+						},
+						"source_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Source IP of the last denied request.",
+						},
+						"hit_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of denied requests.",
+						},
+						"last_seen": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last denied request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixFQDNStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	fqdnTag := d.Get("fqdn_tag").(string)
+	gwName := d.Get("gw_name").(string)
+	hours := d.Get("time_window_hours").(int)
+
+	tags, err := client.ListFQDNTags()
+	if err != nil {
+		return diag.Errorf("could not list FQDN tags: %v", err)
+	}
+
+	var tagNames []string
+	for _, tag := range tags {
+		if fqdnTag == "" || tag.FQDNTag == fqdnTag {
+			tagNames = append(tagNames, tag.FQDNTag)
+		}
+	}
+	if fqdnTag != "" && len(tagNames) == 0 {
+		return diag.Errorf("could not find FQDN tag %s", fqdnTag)
+	}
+	sort.Strings(tagNames)
+
+	rulesByTag := make(map[string][]*goaviatrix.Filters)
+	tagsByGw := make(map[string][]string)
+	if gwName != "" {
+		tagsByGw[gwName] = nil
+	}
+	for _, tagName := range tagNames {
+		gws, err := client.ListGws(&goaviatrix.FQDN{FQDNTag: tagName})
+		if err != nil {
+			return diag.Errorf("could not list gateways attached to FQDN tag %s: %v", tagName, err)
+		}
+		for _, gw := range gws {
+			if gwName == "" || gw == gwName {
+				tagsByGw[gw] = append(tagsByGw[gw], tagName)
+			}
+		}
+
+		fqdn, err := client.ListDomains(&goaviatrix.FQDN{FQDNTag: tagName})
+		if err != nil {
+			return diag.Errorf("could not list domain names of FQDN tag %s: %v", tagName, err)
+		}
+		rulesByTag[tagName] = fqdn.DomainList
+	}
+
+	var gwNames []string
+	for gw := range tagsByGw {
+		gwNames = append(gwNames, gw)
+	}
+	sort.Strings(gwNames)
+
+	endTime := time.Now()
+	startTime := endTime.Add(-time.Duration(hours) * time.Hour)
+
+	var ruleStats, deniedDomains []map[string]interface{}
+	for _, gw := range gwNames {
+		stats, err := client.GetFQDNGatewayStats(ctx, gw, startTime.Unix(), endTime.Unix())
+		if err != nil {
+			return diag.Errorf("could not get FQDN statistics of gateway %s: %v", gw, err)
+		}
+
+		hits := make(map[string]goaviatrix.FQDNRuleHit)
+		for _, hit := range stats.RuleHits {
+			hits[strings.Join([]string{hit.FQDNTag, hit.FQDN, hit.Protocol, hit.Port, hit.Verdict}, "~")] = hit
+		}
+
+		for _, tagName := range tagsByGw[gw] {
+			for _, rule := range rulesByTag[tagName] {
+				hit := hits[strings.Join([]string{tagName, rule.FQDN, rule.Protocol, rule.Port, rule.Verdict}, "~")]
+				ruleStats = append(ruleStats, map[string]interface{}{
+					"fqdn_tag":  tagName,
+					"gw_name":   gw,
+					"fqdn":      rule.FQDN,
+					"protocol":  rule.Protocol,
+					"port":      rule.Port,
+					"action":    rule.Verdict,
+					"hit_count": hit.HitCount,
+					"last_hit":  hit.LastHit,
+				})
+			}
+		}
+
+		for _, denied := range stats.DeniedRequests {
+			deniedDomains = append(deniedDomains, map[string]interface{}{
+				"gw_name":   gw,
+				"fqdn":      denied.FQDN,
+				"protocol":  denied.Protocol,
+				"port":      denied.Port,
+				"source_ip": denied.SourceIP,
+				"hit_count": denied.HitCount,
+				"last_seen": denied.LastSeen,
+			})
+		}
+	}
+
+	if err := d.Set("rule_stats", ruleStats); err != nil {
+		return diag.Errorf("couldn't set rule_stats: %v", err)
+	}
+	if err := d.Set("denied_domains", deniedDomains); err != nil {
+		return diag.Errorf("couldn't set denied_domains: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("fqdn_stats~%s~%s~%d", fqdnTag, gwName, hours))
+	return nil
+}
@@ -0,0 +1,118 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixFQDNStats_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_fqdn_stats.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_FQDN_STATS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source FQDN Stats tests as SKIP_DATA_FQDN_STATS is set")
+	}
+	msg := ". Set SKIP_DATA_FQDN_STATS to yes to skip Data Source FQDN Stats tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFQDNStatsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixFQDNStats(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_stats.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_stats.0.fqdn_tag", fmt.Sprintf("tag-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rule_stats.0.gw_name", fmt.Sprintf("tfg-aws-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rule_stats.0.fqdn", "facebook.com"),
+					resource.TestCheckResourceAttr(resourceName, "rule_stats.0.action", "Allow"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFQDNStatsConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_acc_aws" {
+	account_name       = "tf-acc-aws-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+
+resource "aviatrix_vpc" "test_vpc" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_acc_aws.account_name
+	region       = "%[5]s"
+	name         = "aws-vpc-%[1]s"
+	cidr         = "10.0.10.0/24"
+}
+
+data "aviatrix_vpc" "test_vpc" {
+	name = aviatrix_vpc.test_vpc.name
+}
+
+resource "aviatrix_gateway" "test_gw_aws" {
+	cloud_type     = 1
+	account_name   = aviatrix_account.test_acc_aws.account_name
+	gw_name        = "tfg-aws-%[1]s"
+	vpc_id         = aviatrix_vpc.test_vpc.vpc_id
+	vpc_reg        = "%[5]s"
+	gw_size        = "t2.micro"
+	subnet         = data.aviatrix_vpc.test_vpc.public_subnets[0].cidr
+	single_ip_snat = true
+}
+
+resource "aviatrix_fqdn" "test_fqdn" {
+	fqdn_tag     = "tag-%[1]s"
+	fqdn_enabled = true
+	fqdn_mode    = "white"
+
+	gw_filter_tag_list {
+		gw_name        = aviatrix_gateway.test_gw_aws.gw_name
+		source_ip_list = [
+			"172.31.0.0/16",
+			"172.31.0.0/20",
+		]
+	}
+
+	domain_names {
+		fqdn   = "facebook.com"
+		proto  = "tcp"
+		port   = "443"
+		action = "Allow"
+	}
+}
+
+data "aviatrix_fqdn_stats" "foo" {
+	fqdn_tag          = aviatrix_fqdn.test_fqdn.fqdn_tag
+	gw_name           = aviatrix_gateway.test_gw_aws.gw_name
+	time_window_hours = 1
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_REGION"))
+}
+
+func testAccDataSourceAviatrixFQDNStats(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
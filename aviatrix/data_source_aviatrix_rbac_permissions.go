@@ -0,0 +1,95 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixRbacPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixRbacPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return permissions of this type.",
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of RBAC permissions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission name.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission display name.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission description.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Permission type.",
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of all returned permissions.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixRbacPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	permissionType := d.Get("type").(string)
+
+	catalogue, err := client.ListRbacPermissions()
+	if err != nil {
+		return diag.Errorf("could not list RBAC permissions: %v", err)
+	}
+
+	var permissions []map[string]interface{}
+	var names []string
+	for _, permission := range catalogue {
+		if permissionType != "" && permission.Type != permissionType {
+			continue
+		}
+		permissions = append(permissions, map[string]interface{}{
+			"name":         permission.Name,
+			"display_name": permission.DisplayName,
+			"description":  permission.Description,
+			"type":         permission.Type,
+		})
+		names = append(names, permission.Name)
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.Errorf("couldn't set permissions: %v", err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("couldn't set names: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("rbac_permissions~%s", permissionType))
+	return nil
+}
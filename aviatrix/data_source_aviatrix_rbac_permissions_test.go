@@ -0,0 +1,55 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixRbacPermissions_basic(t *testing.T) {
+	resourceName := "data.aviatrix_rbac_permissions.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_RBAC_PERMISSIONS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source RBAC Permissions tests as SKIP_DATA_RBAC_PERMISSIONS is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRbacPermissionsConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixRbacPermissions(resourceName),
+					resource.TestCheckTypeSetElemAttr(resourceName, "names.*", "all_write"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permissions.*", map[string]string{
+						"name": "all_gateway_write",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRbacPermissionsConfigBasic() string {
+	return `
+data "aviatrix_rbac_permissions" "foo" {}
+	`
+}
+
+func testAccDataSourceAviatrixRbacPermissions(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixVPNSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixVPNSessionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return sessions of this VPN user.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return sessions on this VPN gateway or load balancer.",
+			},
+			"sessions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of connected VPN users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPN user name.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VPN gateway or load balancer the user is connected to.",
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Public IP the user connected from.",
+						},
+						"virtual_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Virtual IP assigned to the user.",
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Profile applied to the session.",
+						},
+						"connect_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the user connected.",
+						},
+						"bytes_received": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of bytes received from the user.",
+						},
+						"bytes_sent": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of bytes sent to the user.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixVPNSessionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	userName := d.Get("user_name").(string)
+	gwName := d.Get("gw_name").(string)
+
+	vpnSessions, err := client.ListVPNSessions(ctx)
+	if err != nil {
+		return diag.Errorf("could not list VPN sessions: %v", err)
+	}
+
+	var sessions []map[string]interface{}
+	for _, session := range vpnSessions {
+		if (userName != "" && session.UserName != userName) || (gwName != "" && session.GwName != gwName) {
+			continue
+		}
+		sessions = append(sessions, map[string]interface{}{
+			"user_name":      session.UserName,
+			"gw_name":        session.GwName,
+			"public_ip":      session.PublicIP,
+			"virtual_ip":     session.VirtualIP,
+			"profile":        session.Profile,
+			"connect_time":   session.ConnectTime,
+			"bytes_received": session.BytesIn,
+			"bytes_sent":     session.BytesOut,
+		})
+	}
+
+	if err := d.Set("sessions", sessions); err != nil {
+		return diag.Errorf("couldn't set sessions: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("vpn_sessions~%s~%s", userName, gwName))
+	return nil
+}
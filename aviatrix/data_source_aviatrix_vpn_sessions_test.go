@@ -0,0 +1,81 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAviatrixVPNSessions_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "data.aviatrix_vpn_sessions.foo"
+
+	skipAcc := os.Getenv("SKIP_DATA_VPN_SESSIONS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Data Source VPN Sessions tests as SKIP_DATA_VPN_SESSIONS is set")
+	}
+	msg := ". Set SKIP_DATA_VPN_SESSIONS to yes to skip Data Source VPN Sessions tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPNSessionsConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAviatrixVPNSessions(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sessions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPNSessionsConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_account.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+	vpn_access   = true
+	vpn_cidr     = "192.168.43.0/24"
+	max_vpn_conn = "100"
+	enable_elb   = true
+	elb_name     = "tfl-%[1]s"
+}
+data "aviatrix_vpn_sessions" "foo" {
+	gw_name = aviatrix_gateway.test_gw.elb_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
+}
+
+func testAccDataSourceAviatrixVPNSessions(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("root module has no data source called %s", name)
+		}
+
+		return nil
+	}
+}
@@ -68,6 +68,12 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"enforce_account_audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail plans that create or change gateways whose access account failed its audit.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -82,6 +88,9 @@ func Provider() *schema.Provider {
 			"aviatrix_aws_tgw_connect_peer":                           resourceAviatrixAwsTgwConnectPeer(),
 			"aviatrix_aws_tgw_directconnect":                          resourceAviatrixAWSTgwDirectConnect(),
 			"aviatrix_aws_tgw_intra_domain_inspection":                resourceAviatrixAwsTgwIntraDomainInspection(),
+			"aviatrix_aws_tgw_multicast_domain":                       resourceAviatrixAwsTgwMulticastDomain(),
+			"aviatrix_aws_tgw_multicast_domain_association":           resourceAviatrixAwsTgwMulticastDomainAssociation(),
+			"aviatrix_aws_tgw_multicast_group_member":                 resourceAviatrixAwsTgwMulticastGroupMember(),
 			"aviatrix_aws_tgw_network_domain":                         resourceAviatrixAwsTgwNetworkDomain(),
 			"aviatrix_aws_tgw_peering":                                resourceAviatrixAWSTgwPeering(),
 			"aviatrix_aws_tgw_peering_domain_conn":                    resourceAviatrixAWSTgwPeeringDomainConn(),
@@ -96,6 +105,7 @@ func Provider() *schema.Provider {
 			"aviatrix_centralized_transit_firenet":                    resourceAviatrixCentralizedTransitFireNet(),
 			"aviatrix_cloudn_registration":                            resourceAviatrixCloudnRegistration(),
 			"aviatrix_cloudn_transit_gateway_attachment":              resourceAviatrixCloudnTransitGatewayAttachment(),
+			"aviatrix_cloudn_upgrade":                                 resourceAviatrixCloudnUpgrade(),
 			"aviatrix_cloudwatch_agent":                               resourceAviatrixCloudwatchAgent(),
 			"aviatrix_controller_bgp_max_as_limit_config":             resourceAviatrixControllerBgpMaxAsLimitConfig(),
 			"aviatrix_controller_cert_domain_config":                  resourceAviatrixControllerCertDomainConfig(),
@@ -114,11 +124,16 @@ func Provider() *schema.Provider {
 			"aviatrix_distributed_firewalling_policy_list":            resourceAviatrixDistributedFirewallingPolicyList(),
 			"aviatrix_edge_caag":                                      resourceAviatrixEdgeCaag(),
 			"aviatrix_edge_csp":                                       resourceAviatrixEdgeCSP(),
+			"aviatrix_edge_csp_compute_node":                          resourceAviatrixEdgeCSPComputeNode(),
+			"aviatrix_edge_csp_project":                               resourceAviatrixEdgeCSPProject(),
 			"aviatrix_edge_spoke":                                     resourceAviatrixEdgeSpoke(),
 			"aviatrix_edge_spoke_external_device_conn":                resourceAviatrixEdgeSpokeExternalDeviceConn(),
+			"aviatrix_edge_spoke_ha":                                  resourceAviatrixEdgeSpokeHa(),
 			"aviatrix_edge_spoke_transit_attachment":                  resourceAviatrixEdgeSpokeTransitAttachment(),
+			"aviatrix_edge_ztp_bundle":                                resourceAviatrixEdgeZtpBundle(),
 			"aviatrix_filebeat_forwarder":                             resourceAviatrixFilebeatForwarder(),
 			"aviatrix_firenet":                                        resourceAviatrixFireNet(),
+			"aviatrix_firenet_firewall_route_sync":                    resourceAviatrixFireNetFirewallRouteSync(),
 			"aviatrix_firewall":                                       resourceAviatrixFirewall(),
 			"aviatrix_firewall_instance":                              resourceAviatrixFirewallInstance(),
 			"aviatrix_firewall_instance_association":                  resourceAviatrixFirewallInstanceAssociation(),
@@ -126,6 +141,7 @@ func Provider() *schema.Provider {
 			"aviatrix_firewall_policy":                                resourceAviatrixFirewallPolicy(),
 			"aviatrix_firewall_tag":                                   resourceAviatrixFirewallTag(),
 			"aviatrix_fqdn":                                           resourceAviatrixFQDN(),
+			"aviatrix_fqdn_discovery":                                 resourceAviatrixFQDNDiscovery(),
 			"aviatrix_fqdn_global_config":                             resourceAviatrixFQDNGlobalConfig(),
 			"aviatrix_fqdn_pass_through":                              resourceAviatrixFQDNPassThrough(),
 			"aviatrix_fqdn_tag_rule":                                  resourceAviatrixFQDNTagRule(),
@@ -148,6 +164,7 @@ func Provider() *schema.Provider {
 			"aviatrix_segmentation_network_domain":                    resourceAviatrixSegmentationNetworkDomain(),
 			"aviatrix_segmentation_network_domain_association":        resourceAviatrixSegmentationNetworkDomainAssociation(),
 			"aviatrix_segmentation_network_domain_connection_policy":  resourceAviatrixSegmentationNetworkDomainConnectionPolicy(),
+			"aviatrix_segmentation_policy_matrix":                     resourceAviatrixSegmentationPolicyMatrix(),
 			"aviatrix_segmentation_security_domain":                   resourceAviatrixSegmentationSecurityDomain(),
 			"aviatrix_segmentation_security_domain_association":       resourceAviatrixSegmentationSecurityDomainAssociation(),
 			"aviatrix_segmentation_security_domain_connection_policy": resourceAviatrixSegmentationSecurityDomainConnectionPolicy(),
@@ -173,19 +190,30 @@ func Provider() *schema.Provider {
 			"aviatrix_vpc":                                            resourceAviatrixVpc(),
 			"aviatrix_vpn_cert_download":                              resourceAviatrixVPNCertDownload(),
 			"aviatrix_vpn_profile":                                    resourceAviatrixProfile(),
+			"aviatrix_vpn_session_disconnect":                         resourceAviatrixVPNSessionDisconnect(),
 			"aviatrix_vpn_user":                                       resourceAviatrixVPNUser(),
 			"aviatrix_vpn_user_accelerator":                           resourceAviatrixVPNUserAccelerator(),
+			"aviatrix_vpn_users":                                      resourceAviatrixVPNUsers(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"aviatrix_account":                          dataSourceAviatrixAccount(),
+			"aviatrix_account_audit":                    dataSourceAviatrixAccountAudit(),
+			"aviatrix_aws_tgw_route_tables":             dataSourceAviatrixAwsTgwRouteTables(),
 			"aviatrix_caller_identity":                  dataSourceAviatrixCallerIdentity(),
+			"aviatrix_cloudn_devices":                   dataSourceAviatrixCloudnDevices(),
+			"aviatrix_connection_status":                dataSourceAviatrixConnectionStatus(),
 			"aviatrix_device_interfaces":                dataSourceAviatrixDeviceInterfaces(),
+			"aviatrix_edge_gateways":                    dataSourceAviatrixEdgeGateways(),
 			"aviatrix_firenet":                          dataSourceAviatrixFireNet(),
 			"aviatrix_firenet_firewall_manager":         dataSourceAviatrixFireNetFirewallManager(),
+			"aviatrix_firenet_health":                   dataSourceAviatrixFireNetHealth(),
 			"aviatrix_firenet_vendor_integration":       dataSourceAviatrixFireNetVendorIntegration(),
+			"aviatrix_fqdn_discovered_domains":          dataSourceAviatrixFQDNDiscoveredDomains(),
+			"aviatrix_fqdn_stats":                       dataSourceAviatrixFQDNStats(),
 			"aviatrix_gateway":                          dataSourceAviatrixGateway(),
 			"aviatrix_gateway_image":                    dataSourceAviatrixGatewayImage(),
 			"aviatrix_network_domains":                  dataSourceAviatrixNetworkDomains(),
+			"aviatrix_rbac_permissions":                 dataSourceAviatrixRbacPermissions(),
 			"aviatrix_spoke_gateway":                    dataSourceAviatrixSpokeGateway(),
 			"aviatrix_spoke_gateways":                   dataSourceAviatrixSpokeGateways(),
 			"aviatrix_spoke_gateway_inspection_subnets": dataSourceAviatrixSpokeGatewayInspectionSubnets(),
@@ -193,7 +221,9 @@ func Provider() *schema.Provider {
 			"aviatrix_transit_gateways":                 dataSourceAviatrixTransitGateways(),
 			"aviatrix_vpc":                              dataSourceAviatrixVpc(),
 			"aviatrix_vpc_tracker":                      dataSourceAviatrixVpcTracker(),
+			"aviatrix_vpn_sessions":                     dataSourceAviatrixVPNSessions(),
 			"aviatrix_firewall":                         dataSourceAviatrixFirewall(),
+			"aviatrix_firewall_instance_bootstrap":      dataSourceAviatrixFirewallInstanceBootstrap(),
 			"aviatrix_firewall_instance_images":         dataSourceAviatrixFirewallInstanceImages(),
 		},
 		ConfigureFunc: aviatrixConfigure,
@@ -212,12 +242,13 @@ func envDefaultFunc(k string) schema.SchemaDefaultFunc {
 
 func aviatrixConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
-		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
-		PathToCACert: d.Get("path_to_ca_certificate").(string),
-		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		ControllerIP:        d.Get("controller_ip").(string),
+		Username:            d.Get("username").(string),
+		Password:            d.Get("password").(string),
+		VerifyCert:          d.Get("verify_ssl_certificate").(bool),
+		PathToCACert:        d.Get("path_to_ca_certificate").(string),
+		IgnoreTags:          expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		EnforceAccountAudit: d.Get("enforce_account_audit").(bool),
 	}
 
 	skipVersionValidation := d.Get("skip_version_validation").(bool)
@@ -240,12 +271,13 @@ func aviatrixConfigure(d *schema.ResourceData) (interface{}, error) {
 
 func aviatrixConfigureWithoutVersionValidation(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
-		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
-		PathToCACert: d.Get("path_to_ca_certificate").(string),
-		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		ControllerIP:        d.Get("controller_ip").(string),
+		Username:            d.Get("username").(string),
+		Password:            d.Get("password").(string),
+		VerifyCert:          d.Get("verify_ssl_certificate").(bool),
+		PathToCACert:        d.Get("path_to_ca_certificate").(string),
+		IgnoreTags:          expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		EnforceAccountAudit: d.Get("enforce_account_audit").(bool),
 	}
 
 	return config.Client()
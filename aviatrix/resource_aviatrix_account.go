@@ -2,10 +2,13 @@ package aviatrix
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,10 +66,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "AWS Access Key.",
 			},
 			"aws_secret_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "AWS Secret Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "AWS Secret Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"awsgov_account_number": {
 				Type:         schema.TypeString,
@@ -98,10 +102,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "AWS Gov Access Key.",
 			},
 			"awsgov_secret_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "AWS Gov Secret Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "AWS Gov Secret Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"gcloud_project_id": {
 				Type:        schema.TypeString,
@@ -131,10 +136,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure Application ID.",
 			},
 			"arm_application_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure Application Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Azure Application Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"oci_tenancy_id": {
 				Type:        schema.TypeString,
@@ -178,10 +184,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure Gov Application ID.",
 			},
 			"azuregov_application_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure Gov Application Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Azure Gov Application Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"alicloud_account_id": {
 				Type:        schema.TypeString,
@@ -195,10 +202,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Alibaba Cloud Access Key.",
 			},
 			"alicloud_secret_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Alibaba Cloud Secret Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Alibaba Cloud Secret Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"audit_account": {
 				Type:        schema.TypeBool,
@@ -225,11 +233,12 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description:   "AWS China Access Key.",
 			},
 			"awschina_secret_key": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"awschina_role_app", "awschina_role_ec2"},
-				Description:   "AWS China Secret Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"awschina_role_app", "awschina_role_ec2"},
+				Description:      "AWS China Secret Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"azurechina_subscription_id": {
 				Type:        schema.TypeString,
@@ -249,10 +258,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Azure China Application ID.",
 			},
 			"azurechina_application_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Azure China Application Key.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Azure China Application Key.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"awsts_account_number": {
 				Type:        schema.TypeString,
@@ -346,10 +356,11 @@ func resourceAviatrixAccount() *schema.Resource {
 				Description: "Edge CSP username.",
 			},
 			"edge_csp_password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Edge CSP password.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				Description:      "Edge CSP password.",
+				DiffSuppressFunc: suppressAccountSecretDiff,
 			},
 			"aws_role_app": {
 				Type:        schema.TypeString,
@@ -410,6 +421,31 @@ func resourceAviatrixAccount() *schema.Resource {
 				},
 				Description: "List of RBAC permission group names.",
 			},
+			"write_only_credentials": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Only store a SHA-256 hash of the secret keys, application keys and passwords in the state. " +
+					"The secrets are read from the configuration when they are sent to the controller. " +
+					"This is not a Terraform write-only argument: the secrets are still part of the configuration and plan.",
+			},
+			"check_audit_record_on_rotation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Check the latest account audit record on the controller after the credentials are rotated. " +
+					"If it reports a failure, the previous credentials are restored when they are known and the update fails.",
+			},
+			"credentials_last_rotated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the account credentials were last set by Terraform, in RFC 3339 format.",
+			},
+			"audit_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the last account audit.",
+			},
 		},
 	}
 }
@@ -791,6 +827,9 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to create Aviatrix Account: %s", err)
 	}
 
+	d.Set("credentials_last_rotated", time.Now().UTC().Format(time.RFC3339))
+	hashAccountSecrets(d)
+
 	return resourceAviatrixAccountReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -910,19 +949,29 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		d.Set("rbac_groups", acc.GroupNamesRead)
+
 		d.SetId(acc.AccountName)
 	}
 
 	// Don't check account audit during import. In terraform version 0.14.11 or earlier, returning diag.Warning during import
 	// will cause it to fail silently. It will not return an error, but the state file will not be updated after.
 	if d.Get("audit_account").(bool) && !isImport {
-		err = client.AuditAccount(ctx, account)
-		if err != nil {
+		accountAuditResult, err := client.GetAccountAuditResult(ctx, account.AccountName)
+		if err != nil && err != goaviatrix.ErrNotFound {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "Aviatrix Account failed audit",
 				Detail:   fmt.Sprintf("%v", err),
 			})
+		} else if err == nil {
+			d.Set("audit_status", accountAuditResult.Status)
+			if !accountAuditResult.Passed() {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Aviatrix Account failed audit",
+					Detail:   accountAuditResult.Comment,
+				})
+			}
 		}
 	}
 
@@ -1002,10 +1051,15 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			AccountName:     d.Get("account_name").(string),
 			CloudType:       d.Get("cloud_type").(int),
 			EdgeCSPUsername: d.Get("edge_csp_username").(string),
-			EdgeCSPPassword: d.Get("edge_csp_password").(string),
+			EdgeCSPPassword: getAccountSecret(d, "edge_csp_password"),
 		}
 	}
 
+	// with write_only_credentials the state only holds hashes of the secrets
+	for _, key := range accountSecretKeys {
+		*accountCredentialFields[key](account) = getAccountSecret(d, key)
+	}
+
 	awsIam := d.Get("aws_iam").(bool)
 	account.AwsIam = strconv.FormatBool(awsIam)
 
@@ -1015,7 +1069,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	awsChinaIam := d.Get("awschina_iam").(bool)
 	account.AwsChinaIam = strconv.FormatBool(awsChinaIam)
 
-	log.Printf("[INFO] Updating Aviatrix account: %#v", account)
+	log.Printf("[INFO] Updating Aviatrix account: %s", account.AccountName)
 
 	d.Partial(true)
 
@@ -1196,11 +1250,32 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	var rotationDiags diag.Diagnostics
+	if d.HasChanges(accountCredentialKeys...) {
+		if d.Get("check_audit_record_on_rotation").(bool) {
+			rotationDiags = append(rotationDiags, checkRotatedAccountAuditRecord(ctx, d, client, account)...)
+		}
+
+		gatewayNames, err := client.ListAccountGatewayNames(ctx, account.AccountName)
+		if err != nil {
+			log.Printf("[WARN] Could not list gateways of Aviatrix account %s: %v", account.AccountName, err)
+		} else if len(gatewayNames) != 0 {
+			rotationDiags = append(rotationDiags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("rotated credentials of Aviatrix account %s are used by gateways", account.AccountName),
+				Detail:   strings.Join(gatewayNames, ", "),
+			})
+		}
+
+		d.Set("credentials_last_rotated", time.Now().UTC().Format(time.RFC3339))
+	}
+	hashAccountSecrets(d)
+
 	d.Partial(false)
-	return resourceAviatrixAccountRead(ctx, d, meta)
+	return append(rotationDiags, resourceAviatrixAccountRead(ctx, d, meta)...)
 }
 
-//for now, deleting gcp account will not delete the credential file
+// for now, deleting gcp account will not delete the credential file
 func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 	account := &goaviatrix.Account{
@@ -1217,6 +1292,113 @@ func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+const accountSecretHashPrefix = "sha256:"
+
+// accountCredentialFields maps the credential arguments that can be rotated in place to their fields in goaviatrix.Account.
+var accountCredentialFields = map[string]func(*goaviatrix.Account) *string{
+	"aws_access_key":                      func(a *goaviatrix.Account) *string { return &a.AwsAccessKey },
+	"aws_secret_key":                      func(a *goaviatrix.Account) *string { return &a.AwsSecretKey },
+	"awsgov_access_key":                   func(a *goaviatrix.Account) *string { return &a.AwsgovAccessKey },
+	"awsgov_secret_key":                   func(a *goaviatrix.Account) *string { return &a.AwsgovSecretKey },
+	"awschina_access_key":                 func(a *goaviatrix.Account) *string { return &a.AwsChinaAccessKey },
+	"awschina_secret_key":                 func(a *goaviatrix.Account) *string { return &a.AwsChinaSecretKey },
+	"arm_application_id":                  func(a *goaviatrix.Account) *string { return &a.ArmApplicationClientId },
+	"arm_application_key":                 func(a *goaviatrix.Account) *string { return &a.ArmApplicationClientSecret },
+	"azuregov_application_id":             func(a *goaviatrix.Account) *string { return &a.AzuregovApplicationClientId },
+	"azuregov_application_key":            func(a *goaviatrix.Account) *string { return &a.AzuregovApplicationClientSecret },
+	"azurechina_application_id":           func(a *goaviatrix.Account) *string { return &a.AzureChinaApplicationClientId },
+	"azurechina_application_key":          func(a *goaviatrix.Account) *string { return &a.AzureChinaApplicationClientSecret },
+	"alicloud_access_key":                 func(a *goaviatrix.Account) *string { return &a.AlicloudAccessKey },
+	"alicloud_secret_key":                 func(a *goaviatrix.Account) *string { return &a.AlicloudSecretKey },
+	"gcloud_project_credentials_filepath": func(a *goaviatrix.Account) *string { return &a.GcloudProjectCredentialsFilepathLocal },
+}
+
+// accountSecretKeys are the credential arguments that are only stored as a hash with write_only_credentials.
+var accountSecretKeys = []string{
+	"aws_secret_key",
+	"awsgov_secret_key",
+	"awschina_secret_key",
+	"arm_application_key",
+	"azuregov_application_key",
+	"azurechina_application_key",
+	"alicloud_secret_key",
+}
+
+var accountCredentialKeys = []string{
+	"aws_access_key", "aws_secret_key", "awsgov_access_key", "awsgov_secret_key", "awschina_access_key", "awschina_secret_key",
+	"arm_application_id", "arm_application_key", "azuregov_application_id", "azuregov_application_key",
+	"azurechina_application_id", "azurechina_application_key", "alicloud_access_key", "alicloud_secret_key",
+	"gcloud_project_credentials_filepath", "edge_csp_password",
+}
+
+func hashAccountSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return accountSecretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+func suppressAccountSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("write_only_credentials").(bool) && strings.HasPrefix(old, accountSecretHashPrefix) && old == hashAccountSecret(new)
+}
+
+// getAccountSecret returns the secret of the given argument. If the state only holds a hash of the secret, the
+// secret is read from the configuration.
+func getAccountSecret(d *schema.ResourceData, key string) string {
+	secret := d.Get(key).(string)
+	if !strings.HasPrefix(secret, accountSecretHashPrefix) {
+		return secret
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return ""
+	}
+	rawSecret := rawConfig.GetAttr(key)
+	if rawSecret.IsNull() || !rawSecret.IsKnown() {
+		return ""
+	}
+	return rawSecret.AsString()
+}
+
+// hashAccountSecrets replaces the secrets in the state with their hashes when write_only_credentials is enabled.
+func hashAccountSecrets(d *schema.ResourceData) {
+	if !d.Get("write_only_credentials").(bool) {
+		return
+	}
+	for _, key := range append(accountSecretKeys, "edge_csp_password") {
+		secret := d.Get(key).(string)
+		if secret != "" && !strings.HasPrefix(secret, accountSecretHashPrefix) {
+			d.Set(key, hashAccountSecret(secret))
+		}
+	}
+}
+
+// checkRotatedAccountAuditRecord checks the latest audit record of the account after its credentials were rotated. The
+// record is not refreshed by the rotation, so it may still reflect the previous credentials. A failure is therefore only
+// reported as a warning and the rotated credentials are kept.
+func checkRotatedAccountAuditRecord(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, account *goaviatrix.Account) diag.Diagnostics {
+	accountAuditResult, err := client.GetAccountAuditResult(ctx, account.AccountName)
+	if err == goaviatrix.ErrNotFound {
+		log.Printf("[WARN] No audit record found for Aviatrix account %s after rotating its credentials", account.AccountName)
+		return nil
+	}
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("could not check the audit record of Aviatrix account %s after rotating its credentials", account.AccountName),
+			Detail:   err.Error(),
+		}}
+	}
+	d.Set("audit_status", accountAuditResult.Status)
+	if accountAuditResult.Passed() {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("latest audit record of Aviatrix account %s reports a failure after rotating its credentials", account.AccountName),
+		Detail:   fmt.Sprintf("The record may predate the rotation: %s", accountAuditResult.Comment),
+	}}
+}
+
 // Validate account number string is 12 digits
 func validateAwsAccountNumber(val interface{}, key string) (warns []string, errs []error) {
 	v := val.(string)
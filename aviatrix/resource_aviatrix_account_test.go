@@ -8,6 +8,7 @@ import (
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -172,7 +173,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 	var account goaviatrix.Account
 
 	rInt := acctest.RandInt()
-	importStateVerifyIgnore := []string{"aws_secret_key", "aws_access_key", "audit_account"}
+	importStateVerifyIgnore := []string{"aws_secret_key", "aws_access_key", "audit_account", "credentials_last_rotated"}
 
 	skipAcc := os.Getenv("SKIP_ACCOUNT")
 	skipAWS := os.Getenv("SKIP_ACCOUNT_AWS")
@@ -216,6 +217,7 @@ func TestAccAviatrixAccount_basic(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "aws_iam", "false"),
 						resource.TestCheckResourceAttr(resourceName, "aws_access_key", os.Getenv("AWS_ACCESS_KEY")),
 						resource.TestCheckResourceAttr(resourceName, "aws_secret_key", os.Getenv("AWS_SECRET_KEY")),
+						resource.TestCheckResourceAttrSet(resourceName, "credentials_last_rotated"),
 					),
 				},
 				{
@@ -772,3 +774,51 @@ func testAccCheckAccountDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestSuppressAccountSecretDiff(t *testing.T) {
+	secret := "secret-key"
+
+	tt := []struct {
+		Name                 string
+		WriteOnlyCredentials bool
+		Old                  string
+		New                  string
+		Suppress             bool
+	}{
+		{
+			Name:                 "hash of same secret",
+			WriteOnlyCredentials: true,
+			Old:                  hashAccountSecret(secret),
+			New:                  secret,
+			Suppress:             true,
+		},
+		{
+			Name:                 "rotated secret",
+			WriteOnlyCredentials: true,
+			Old:                  hashAccountSecret(secret),
+			New:                  "rotated-secret-key",
+		},
+		{
+			Name:                 "plaintext secret in state",
+			WriteOnlyCredentials: true,
+			Old:                  secret,
+			New:                  secret,
+		},
+		{
+			Name: "write only credentials disabled",
+			Old:  hashAccountSecret(secret),
+			New:  secret,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceAviatrixAccount().Schema, map[string]interface{}{
+				"write_only_credentials": test.WriteOnlyCredentials,
+			})
+			if suppress := suppressAccountSecretDiff("aws_secret_key", test.Old, test.New, d); suppress != test.Suppress {
+				t.Errorf("expected suppress to be %t, got %t", test.Suppress, suppress)
+			}
+		})
+	}
+}
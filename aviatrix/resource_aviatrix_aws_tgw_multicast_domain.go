@@ -0,0 +1,147 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixAwsTgwMulticastDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixAwsTgwMulticastDomainCreate,
+		ReadWithoutTimeout:   resourceAviatrixAwsTgwMulticastDomainRead,
+		DeleteWithoutTimeout: resourceAviatrixAwsTgwMulticastDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS TGW name.",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Multicast domain name.",
+			},
+			"igmpv2_support": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Enable IGMPv2 support for the multicast domain.",
+			},
+			"static_sources_support": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Enable static sources support for the multicast domain.",
+			},
+			"auto_accept_shared_associations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Automatically accept cross-account subnet associations.",
+			},
+			"multicast_domain_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the multicast domain.",
+			},
+		},
+	}
+}
+
+func marshalAwsTgwMulticastDomainInput(d *schema.ResourceData) *goaviatrix.AwsTgwMulticastDomain {
+	return &goaviatrix.AwsTgwMulticastDomain{
+		TgwName:                      d.Get("tgw_name").(string),
+		DomainName:                   d.Get("domain_name").(string),
+		Igmpv2Support:                d.Get("igmpv2_support").(bool),
+		StaticSourcesSupport:         d.Get("static_sources_support").(bool),
+		AutoAcceptSharedAssociations: d.Get("auto_accept_shared_associations").(bool),
+	}
+}
+
+func resourceAviatrixAwsTgwMulticastDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	domain := marshalAwsTgwMulticastDomainInput(d)
+	d.SetId(domain.ID())
+	flag := false
+	defer resourceAviatrixAwsTgwMulticastDomainReadIfRequired(ctx, d, meta, &flag)
+
+	if err := client.CreateTgwMulticastDomain(ctx, domain); err != nil {
+		return diag.Errorf("could not create TGW multicast domain: %v", err)
+	}
+
+	return resourceAviatrixAwsTgwMulticastDomainReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixAwsTgwMulticastDomainReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixAwsTgwMulticastDomainRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	tgwName := d.Get("tgw_name").(string)
+	domainName := d.Get("domain_name").(string)
+	if tgwName == "" || domainName == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no aws_tgw_multicast_domain tgw_name or domain_name received. Import Id is %s", id)
+		parts := strings.Split(id, "~~")
+		if len(parts) != 2 {
+			return diag.Errorf("Invalid Import ID received for aws_tgw_multicast_domain, ID must be in the form tgw_name~~domain_name")
+		}
+		tgwName = parts[0]
+		domainName = parts[1]
+		d.SetId(id)
+	}
+
+	domain := &goaviatrix.AwsTgwMulticastDomain{
+		TgwName:    tgwName,
+		DomainName: domainName,
+	}
+	domain, err := client.GetTgwMulticastDomain(ctx, domain)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find aws_tgw_multicast_domain: %v", err)
+	}
+
+	d.Set("tgw_name", domain.TgwName)
+	d.Set("domain_name", domain.DomainName)
+	d.Set("igmpv2_support", domain.Igmpv2Support)
+	d.Set("static_sources_support", domain.StaticSourcesSupport)
+	d.Set("auto_accept_shared_associations", domain.AutoAcceptSharedAssociations)
+	d.Set("multicast_domain_id", domain.MulticastDomainID)
+	d.SetId(domain.ID())
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	domain := marshalAwsTgwMulticastDomainInput(d)
+	if err := client.DeleteTgwMulticastDomain(ctx, domain); err != nil {
+		return diag.Errorf("could not delete TGW multicast domain: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixAwsTgwMulticastDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixAwsTgwMulticastDomainAssociationCreate,
+		ReadWithoutTimeout:   resourceAviatrixAwsTgwMulticastDomainAssociationRead,
+		DeleteWithoutTimeout: resourceAviatrixAwsTgwMulticastDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS TGW name.",
+			},
+			"multicast_domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Multicast domain name.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the VPC attached to the TGW.",
+			},
+			"subnet_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of subnet IDs of the VPC attachment to associate with the multicast domain.",
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the TGW VPC attachment.",
+			},
+		},
+	}
+}
+
+func marshalAwsTgwMulticastDomainAssociationInput(d *schema.ResourceData) *goaviatrix.AwsTgwMulticastDomainAssociation {
+	return &goaviatrix.AwsTgwMulticastDomainAssociation{
+		TgwName:             d.Get("tgw_name").(string),
+		MulticastDomainName: d.Get("multicast_domain_name").(string),
+		VpcID:               d.Get("vpc_id").(string),
+		SubnetIDs:           getStringSet(d, "subnet_ids"),
+	}
+}
+
+func resourceAviatrixAwsTgwMulticastDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	association := marshalAwsTgwMulticastDomainAssociationInput(d)
+	d.SetId(association.ID())
+	flag := false
+	defer resourceAviatrixAwsTgwMulticastDomainAssociationReadIfRequired(ctx, d, meta, &flag)
+
+	if err := client.AssociateTgwMulticastDomain(ctx, association); err != nil {
+		return diag.Errorf("could not create TGW multicast domain association: %v", err)
+	}
+
+	return resourceAviatrixAwsTgwMulticastDomainAssociationReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixAwsTgwMulticastDomainAssociationReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixAwsTgwMulticastDomainAssociationRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	tgwName := d.Get("tgw_name").(string)
+	domainName := d.Get("multicast_domain_name").(string)
+	vpcID := d.Get("vpc_id").(string)
+	if tgwName == "" || domainName == "" || vpcID == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no aws_tgw_multicast_domain_association tgw_name, multicast_domain_name or vpc_id received. Import Id is %s", id)
+		parts := strings.Split(id, "~~")
+		if len(parts) != 3 {
+			return diag.Errorf("Invalid Import ID received for aws_tgw_multicast_domain_association, ID must be in the form tgw_name~~multicast_domain_name~~vpc_id")
+		}
+		tgwName = parts[0]
+		domainName = parts[1]
+		vpcID = parts[2]
+		d.SetId(id)
+	}
+
+	association := &goaviatrix.AwsTgwMulticastDomainAssociation{
+		TgwName:             tgwName,
+		MulticastDomainName: domainName,
+		VpcID:               vpcID,
+	}
+	association, err := client.GetTgwMulticastDomainAssociation(ctx, association)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find aws_tgw_multicast_domain_association: %v", err)
+	}
+
+	d.Set("tgw_name", association.TgwName)
+	d.Set("multicast_domain_name", association.MulticastDomainName)
+	d.Set("vpc_id", association.VpcID)
+	if err := d.Set("subnet_ids", association.SubnetIDs); err != nil {
+		return diag.Errorf("could not set 'subnet_ids' into state: %v", err)
+	}
+	d.Set("attachment_id", association.AttachmentID)
+	d.SetId(association.ID())
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	association := marshalAwsTgwMulticastDomainAssociationInput(d)
+	if err := client.DisassociateTgwMulticastDomain(ctx, association); err != nil {
+		return diag.Errorf("could not delete TGW multicast domain association: %v", err)
+	}
+
+	return nil
+}
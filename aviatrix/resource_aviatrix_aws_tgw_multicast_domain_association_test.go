@@ -0,0 +1,131 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixAwsTgwMulticastDomainAssociation_basic(t *testing.T) {
+	if os.Getenv("SKIP_AWS_TGW_MULTICAST_DOMAIN_ASSOCIATION") == "yes" {
+		t.Skip("Skipping AWS TGW Multicast Domain Association test as SKIP_AWS_TGW_MULTICAST_DOMAIN_ASSOCIATION is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_aws_tgw_multicast_domain_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsTgwMulticastDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsTgwMulticastDomainAssociationBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsTgwMulticastDomainAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tgw_name", "aws-tgw-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "multicast_domain_name", "multicast-domain-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsTgwMulticastDomainAssociationBasic(rName string) string {
+	return testAccAwsTgwMulticastDomainBaseConfig(rName) + fmt.Sprintf(`
+resource "aviatrix_vpc" "test" {
+  cloud_type           = aviatrix_account.aws.cloud_type
+  account_name         = aviatrix_account.aws.account_name
+  region               = "%[2]s"
+  name                 = "tgw-multicast-vpc-%[1]s"
+  cidr                 = "10.10.0.0/16"
+  aviatrix_firenet_vpc = false
+  aviatrix_transit_vpc = false
+}
+
+resource "aviatrix_aws_tgw_network_domain" "test" {
+  name     = "Default_Domain"
+  tgw_name = aviatrix_aws_tgw.test.tgw_name
+}
+
+resource "aviatrix_aws_tgw_vpc_attachment" "test" {
+  tgw_name            = aviatrix_aws_tgw.test.tgw_name
+  region              = "%[2]s"
+  network_domain_name = aviatrix_aws_tgw_network_domain.test.name
+  vpc_account_name    = aviatrix_account.aws.account_name
+  vpc_id              = aviatrix_vpc.test.vpc_id
+}
+
+resource "aviatrix_aws_tgw_multicast_domain_association" "test" {
+  tgw_name              = aviatrix_aws_tgw.test.tgw_name
+  multicast_domain_name = aviatrix_aws_tgw_multicast_domain.test.domain_name
+  vpc_id                = aviatrix_aws_tgw_vpc_attachment.test.vpc_id
+  subnet_ids            = [aviatrix_vpc.test.private_subnets[0].subnet_id]
+}
+`, rName, os.Getenv("AWS_REGION"))
+}
+
+func testAccCheckAwsTgwMulticastDomainAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("aws_tgw_multicast_domain_association Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no aws_tgw_multicast_domain_association ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		foundAssociation := &goaviatrix.AwsTgwMulticastDomainAssociation{
+			TgwName:             rs.Primary.Attributes["tgw_name"],
+			MulticastDomainName: rs.Primary.Attributes["multicast_domain_name"],
+			VpcID:               rs.Primary.Attributes["vpc_id"],
+		}
+
+		foundAssociation, err := client.GetTgwMulticastDomainAssociation(context.Background(), foundAssociation)
+		if err != nil {
+			return err
+		}
+		if foundAssociation.ID() != rs.Primary.ID {
+			return fmt.Errorf("aws_tgw_multicast_domain_association not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsTgwMulticastDomainAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_aws_tgw_multicast_domain_association" {
+			continue
+		}
+		foundAssociation := &goaviatrix.AwsTgwMulticastDomainAssociation{
+			TgwName:             rs.Primary.Attributes["tgw_name"],
+			MulticastDomainName: rs.Primary.Attributes["multicast_domain_name"],
+			VpcID:               rs.Primary.Attributes["vpc_id"],
+		}
+		_, err := client.GetTgwMulticastDomainAssociation(context.Background(), foundAssociation)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("aws_tgw_multicast_domain_association still exists")
+		}
+	}
+
+	return nil
+}
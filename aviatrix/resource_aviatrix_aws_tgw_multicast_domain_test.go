@@ -0,0 +1,123 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixAwsTgwMulticastDomain_basic(t *testing.T) {
+	if os.Getenv("SKIP_AWS_TGW_MULTICAST_DOMAIN") == "yes" {
+		t.Skip("Skipping AWS TGW Multicast Domain test as SKIP_AWS_TGW_MULTICAST_DOMAIN is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_aws_tgw_multicast_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsTgwMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsTgwMulticastDomainBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsTgwMulticastDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tgw_name", "aws-tgw-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "multicast-domain-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "igmpv2_support", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "multicast_domain_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsTgwMulticastDomainBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aviatrix_aws_tgw" "test" {
+  account_name                      = aviatrix_account.aws.account_name
+  aws_side_as_number                = "64512"
+  region                            = "%[3]s"
+  tgw_name                          = "aws-tgw-%[2]s"
+  enable_multicast                  = true
+  manage_security_domain            = false
+  manage_vpc_attachment             = false
+  manage_transit_gateway_attachment = false
+}
+
+resource "aviatrix_aws_tgw_multicast_domain" "test" {
+  tgw_name       = aviatrix_aws_tgw.test.tgw_name
+  domain_name    = "multicast-domain-%[2]s"
+  igmpv2_support = true
+}
+`, testAccAccountConfigAWS(acctest.RandInt()), rName, os.Getenv("AWS_REGION"))
+}
+
+func testAccAwsTgwMulticastDomainBasic(rName string) string {
+	return testAccAwsTgwMulticastDomainBaseConfig(rName)
+}
+
+func testAccCheckAwsTgwMulticastDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("aws_tgw_multicast_domain Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no aws_tgw_multicast_domain ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		foundDomain := &goaviatrix.AwsTgwMulticastDomain{
+			TgwName:    rs.Primary.Attributes["tgw_name"],
+			DomainName: rs.Primary.Attributes["domain_name"],
+		}
+
+		foundDomain, err := client.GetTgwMulticastDomain(context.Background(), foundDomain)
+		if err != nil {
+			return err
+		}
+		if foundDomain.ID() != rs.Primary.ID {
+			return fmt.Errorf("aws_tgw_multicast_domain not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsTgwMulticastDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_aws_tgw_multicast_domain" {
+			continue
+		}
+		foundDomain := &goaviatrix.AwsTgwMulticastDomain{
+			TgwName:    rs.Primary.Attributes["tgw_name"],
+			DomainName: rs.Primary.Attributes["domain_name"],
+		}
+		_, err := client.GetTgwMulticastDomain(context.Background(), foundDomain)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("aws_tgw_multicast_domain still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixAwsTgwMulticastGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixAwsTgwMulticastGroupMemberCreate,
+		ReadWithoutTimeout:   resourceAviatrixAwsTgwMulticastGroupMemberRead,
+		DeleteWithoutTimeout: resourceAviatrixAwsTgwMulticastGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS TGW name.",
+			},
+			"multicast_domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Multicast domain name.",
+			},
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "IP address of the multicast group.",
+			},
+			"member_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "member",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"member", "source"}, false),
+				Description:  "Type of the registered network interfaces. Valid values: 'member', 'source'.",
+			},
+			"network_interface_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of network interface IDs to register with the multicast group.",
+			},
+		},
+	}
+}
+
+func marshalAwsTgwMulticastGroupMemberInput(d *schema.ResourceData) *goaviatrix.AwsTgwMulticastGroupMember {
+	return &goaviatrix.AwsTgwMulticastGroupMember{
+		TgwName:             d.Get("tgw_name").(string),
+		MulticastDomainName: d.Get("multicast_domain_name").(string),
+		GroupIPAddress:      d.Get("group_ip_address").(string),
+		MemberType:          d.Get("member_type").(string),
+		NetworkInterfaceIDs: getStringSet(d, "network_interface_ids"),
+	}
+}
+
+func resourceAviatrixAwsTgwMulticastGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	member := marshalAwsTgwMulticastGroupMemberInput(d)
+	d.SetId(member.ID())
+	flag := false
+	defer resourceAviatrixAwsTgwMulticastGroupMemberReadIfRequired(ctx, d, meta, &flag)
+
+	if err := client.RegisterTgwMulticastGroupMembers(ctx, member); err != nil {
+		return diag.Errorf("could not register TGW multicast group %ss: %v", member.MemberType, err)
+	}
+
+	return resourceAviatrixAwsTgwMulticastGroupMemberReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixAwsTgwMulticastGroupMemberReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixAwsTgwMulticastGroupMemberRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	tgwName := d.Get("tgw_name").(string)
+	domainName := d.Get("multicast_domain_name").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	memberType := d.Get("member_type").(string)
+	if tgwName == "" || domainName == "" || groupIPAddress == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no aws_tgw_multicast_group_member tgw_name, multicast_domain_name or group_ip_address received. Import Id is %s", id)
+		parts := strings.Split(id, "~~")
+		if len(parts) != 4 {
+			return diag.Errorf("Invalid Import ID received for aws_tgw_multicast_group_member, ID must be in the form tgw_name~~multicast_domain_name~~group_ip_address~~member_type")
+		}
+		tgwName = parts[0]
+		domainName = parts[1]
+		groupIPAddress = parts[2]
+		memberType = parts[3]
+		d.SetId(id)
+	}
+
+	member := &goaviatrix.AwsTgwMulticastGroupMember{
+		TgwName:             tgwName,
+		MulticastDomainName: domainName,
+		GroupIPAddress:      groupIPAddress,
+		MemberType:          memberType,
+	}
+	member, err := client.GetTgwMulticastGroupMembers(ctx, member)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find aws_tgw_multicast_group_member: %v", err)
+	}
+
+	d.Set("tgw_name", member.TgwName)
+	d.Set("multicast_domain_name", member.MulticastDomainName)
+	d.Set("group_ip_address", member.GroupIPAddress)
+	d.Set("member_type", member.MemberType)
+	if err := d.Set("network_interface_ids", member.NetworkInterfaceIDs); err != nil {
+		return diag.Errorf("could not set 'network_interface_ids' into state: %v", err)
+	}
+	d.SetId(member.ID())
+	return nil
+}
+
+func resourceAviatrixAwsTgwMulticastGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	member := marshalAwsTgwMulticastGroupMemberInput(d)
+	if err := client.DeregisterTgwMulticastGroupMembers(ctx, member); err != nil {
+		return diag.Errorf("could not deregister TGW multicast group %ss: %v", member.MemberType, err)
+	}
+
+	return nil
+}
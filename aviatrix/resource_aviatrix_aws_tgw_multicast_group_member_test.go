@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixAwsTgwMulticastGroupMember_basic(t *testing.T) {
+	if os.Getenv("SKIP_AWS_TGW_MULTICAST_GROUP_MEMBER") == "yes" {
+		t.Skip("Skipping AWS TGW Multicast Group Member test as SKIP_AWS_TGW_MULTICAST_GROUP_MEMBER is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_aws_tgw_multicast_group_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("AWS_MULTICAST_ENI_ID") == "" {
+				t.Fatal("Environment variable AWS_MULTICAST_ENI_ID is not set. Set SKIP_AWS_TGW_MULTICAST_GROUP_MEMBER to yes to skip AWS TGW Multicast Group Member tests")
+			}
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsTgwMulticastGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsTgwMulticastGroupMemberBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsTgwMulticastGroupMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_ip_address", "224.0.0.100"),
+					resource.TestCheckResourceAttr(resourceName, "member_type", "source"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsTgwMulticastGroupMemberBasic(rName string) string {
+	return testAccAwsTgwMulticastDomainAssociationBasic(rName) + fmt.Sprintf(`
+resource "aviatrix_aws_tgw_multicast_group_member" "test" {
+  tgw_name              = aviatrix_aws_tgw.test.tgw_name
+  multicast_domain_name = aviatrix_aws_tgw_multicast_domain_association.test.multicast_domain_name
+  group_ip_address      = "224.0.0.100"
+  member_type           = "source"
+  network_interface_ids = ["%s"]
+}
+`, os.Getenv("AWS_MULTICAST_ENI_ID"))
+}
+
+func testAccCheckAwsTgwMulticastGroupMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("aws_tgw_multicast_group_member Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no aws_tgw_multicast_group_member ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		foundMember := &goaviatrix.AwsTgwMulticastGroupMember{
+			TgwName:             rs.Primary.Attributes["tgw_name"],
+			MulticastDomainName: rs.Primary.Attributes["multicast_domain_name"],
+			GroupIPAddress:      rs.Primary.Attributes["group_ip_address"],
+			MemberType:          rs.Primary.Attributes["member_type"],
+		}
+
+		foundMember, err := client.GetTgwMulticastGroupMembers(context.Background(), foundMember)
+		if err != nil {
+			return err
+		}
+		if foundMember.ID() != rs.Primary.ID {
+			return fmt.Errorf("aws_tgw_multicast_group_member not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsTgwMulticastGroupMemberDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_aws_tgw_multicast_group_member" {
+			continue
+		}
+		foundMember := &goaviatrix.AwsTgwMulticastGroupMember{
+			TgwName:             rs.Primary.Attributes["tgw_name"],
+			MulticastDomainName: rs.Primary.Attributes["multicast_domain_name"],
+			GroupIPAddress:      rs.Primary.Attributes["group_ip_address"],
+			MemberType:          rs.Primary.Attributes["member_type"],
+		}
+		_, err := client.GetTgwMulticastGroupMembers(context.Background(), foundMember)
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("aws_tgw_multicast_group_member still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixCloudnUpgrade() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixCloudnUpgradeCreate,
+		ReadWithoutTimeout:   resourceAviatrixCloudnUpgradeRead,
+		UpdateWithoutTimeout: resourceAviatrixCloudnUpgradeUpdate,
+		DeleteWithoutTimeout: resourceAviatrixCloudnUpgradeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the CloudN device to upgrade.",
+			},
+			"software_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Software version to upgrade the CloudN device to, e.g. \"7.0\" or \"7.0.1373\". " +
+					"If not set, the device is upgraded to the controller's version.",
+			},
+			"current_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current software version of the CloudN device.",
+			},
+		},
+	}
+}
+
+func upgradeCloudn(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) diag.Diagnostics {
+	deviceName := d.Get("device_name").(string)
+	softwareVersion := d.Get("software_version").(string)
+
+	log.Printf("[INFO] Upgrading CloudN %s to software version %q", deviceName, softwareVersion)
+
+	before, err := client.GetDevice(&goaviatrix.Device{Name: deviceName})
+	if err != nil {
+		return diag.Errorf("could not read CloudN %s: %v", deviceName, err)
+	}
+
+	// the version never changes if the device already runs the target version, so don't wait for it
+	targetVersion := softwareVersion
+	if targetVersion == "" {
+		targetVersion, _, err = client.GetCurrentVersion()
+		if err != nil {
+			return diag.Errorf("could not get the controller version to upgrade CloudN %s to: %v", deviceName, err)
+		}
+	}
+	onVersion, err := goaviatrix.CloudnOnSoftwareVersion(before, targetVersion)
+	if err != nil {
+		return diag.Errorf("could not compare the version of CloudN %s: %v", deviceName, err)
+	}
+	if onVersion {
+		log.Printf("[INFO] CloudN %s already runs software version %s, skipping upgrade", deviceName, before.SoftwareVersion)
+		d.Set("current_version", before.SoftwareVersion)
+		return nil
+	}
+
+	if err := client.UpgradeCloudn(ctx, deviceName, softwareVersion); err != nil {
+		return diag.Errorf("could not upgrade CloudN %s: %v", deviceName, err)
+	}
+
+	device, err := client.WaitForCloudnUpgrade(ctx, before, softwareVersion)
+	if err != nil {
+		return diag.Errorf("failed to wait for upgrade of CloudN %s: %v", deviceName, err)
+	}
+
+	d.Set("current_version", device.SoftwareVersion)
+	return nil
+}
+
+func resourceAviatrixCloudnUpgradeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if diags := upgradeCloudn(ctx, d, client); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(d.Get("device_name").(string))
+	return resourceAviatrixCloudnUpgradeRead(ctx, d, meta)
+}
+
+func resourceAviatrixCloudnUpgradeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	// handle import
+	if d.Get("device_name").(string) == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no device name received. Import Id is %s", id)
+		d.Set("device_name", id)
+		d.SetId(id)
+	}
+
+	device, err := client.GetDevice(&goaviatrix.Device{Name: d.Get("device_name").(string)})
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read CloudN %s: %v", d.Get("device_name").(string), err)
+	}
+
+	d.Set("current_version", device.SoftwareVersion)
+	return nil
+}
+
+func resourceAviatrixCloudnUpgradeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChange("software_version") {
+		if diags := upgradeCloudn(ctx, d, client); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceAviatrixCloudnUpgradeRead(ctx, d, meta)
+}
+
+func resourceAviatrixCloudnUpgradeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
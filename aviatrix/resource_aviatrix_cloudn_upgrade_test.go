@@ -0,0 +1,70 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixCloudnUpgrade_basic(t *testing.T) {
+	if os.Getenv("SKIP_CLOUDN_UPGRADE") == "yes" {
+		t.Skip("Skipping CloudN upgrade test as SKIP_CLOUDN_UPGRADE is set")
+	}
+
+	resourceName := "aviatrix_cloudn_upgrade.test"
+	deviceName := os.Getenv("CLOUDN_DEVICE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudnUpgradeBasic(deviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudnUpgradeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "device_name", deviceName),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudnUpgradeBasic(deviceName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_cloudn_upgrade" "test" {
+	device_name = "%s"
+}
+`, deviceName)
+}
+
+func testAccCheckCloudnUpgradeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("cloudn upgrade not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no cloudn upgrade id is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetDevice(&goaviatrix.Device{Name: rs.Primary.Attributes["device_name"]})
+		if err != nil {
+			return fmt.Errorf("could not find cloudn device: %v", err)
+		}
+		return nil
+	}
+}
@@ -104,12 +104,42 @@ func resourceAviatrixEdgeCaag() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the Edge as a CaaG ZTP file will be stored.",
+				Description: "The location where the Edge as a CaaG ZTP file will be stored. If not set, the ZTP file is returned in 'ztp_file_base64' instead.",
+			},
+			"ztp_file_upload_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "URL the ZTP file is uploaded to with an HTTP PUT request when the gateway is created, e.g. a pre-signed object storage URL.",
+			},
+			"ztp_file_upload_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "HTTP headers sent with the ZTP file upload request.",
+			},
+			"ztp_file_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded ZTP file. Only set if 'ztp_file_download_path' is not set.",
+			},
+			"ztp_file_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the ZTP file.",
 			},
 			"local_as_number": {
 				Type:         schema.TypeString,
@@ -180,6 +210,8 @@ func resourceAviatrixEdgeCaagCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("could not create Edge as a CaaG: %v", err)
 	}
 
+	diags := setEdgeZtpFile(ctx, d, edgeCaag.ZtpFileContent)
+
 	gateway := &goaviatrix.TransitVpc{
 		GwName: edgeCaag.Name,
 	}
@@ -207,7 +239,7 @@ func resourceAviatrixEdgeCaagCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("could not create Edge as a CaaG: prepend_as_path must be empty when local_as_number has not been set")
 	}
 
-	return resourceAviatrixEdgeCaagReadIfRequired(ctx, d, meta, &flag)
+	return append(diags, resourceAviatrixEdgeCaagReadIfRequired(ctx, d, meta, &flag)...)
 }
 
 func resourceAviatrixEdgeCaagReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
@@ -335,16 +367,11 @@ func resourceAviatrixEdgeCaagDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("could not delete Edge as a CaaG: %v", err)
 	}
 
-	var fileName string
-	if ztpFileType == "iso" {
-		fileName = ztpFileDownloadPath + "/" + name + ".iso"
-	} else {
-		fileName = ztpFileDownloadPath + "/" + name + "-cloud-init.txt"
-	}
-
-	err = os.Remove(fileName)
-	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+	if ztpFileDownloadPath != "" {
+		err = os.Remove(ztpFileDownloadPath + "/" + goaviatrix.EdgeZtpFileName(name, ztpFileType))
+		if err != nil {
+			log.Printf("[WARN] could not remove the ztp file: %v", err)
+		}
 	}
 
 	return nil
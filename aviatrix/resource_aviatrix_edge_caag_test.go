@@ -44,7 +44,7 @@ func TestAccAviatrixEdgeCaag_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path", "ztp_file_base64", "ztp_file_sha256"},
 			},
 		},
 	})
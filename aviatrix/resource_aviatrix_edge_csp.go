@@ -24,6 +24,7 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffAccountAudit,
 
 		Schema: map[string]*schema.Schema{
 			"account_name": {
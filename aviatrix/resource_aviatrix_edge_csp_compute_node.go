@@ -0,0 +1,224 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixEdgeCSPComputeNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixEdgeCSPComputeNodeCreate,
+		ReadWithoutTimeout:   resourceAviatrixEdgeCSPComputeNodeRead,
+		UpdateWithoutTimeout: resourceAviatrixEdgeCSPComputeNodeUpdate,
+		DeleteWithoutTimeout: resourceAviatrixEdgeCSPComputeNodeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge CSP account name.",
+			},
+			"project_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the Edge CSP project the compute node is onboarded to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge CSP compute node name.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Serial number of the compute node.",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Hardware model of the compute node.",
+			},
+			"onboarding_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Onboarding key of the compute node. If not set, the default onboarding key of the Edge CSP is used.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Edge CSP compute node description.",
+			},
+			"interfaces": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Mapping of the physical ports of the compute node to Edge gateway interfaces.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logical_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Edge gateway interface name, e.g. \"eth0\".",
+						},
+						"physical_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Physical port name of the compute node model.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Interface type. Valid values: \"WAN\", \"LAN\", \"MGMT\".",
+							ValidateFunc: validation.StringInSlice([]string{"WAN", "LAN", "MGMT"}, false),
+						},
+					},
+				},
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edge CSP compute node UUID.",
+			},
+			"admin_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Administrative state of the compute node.",
+			},
+			"run_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Run state of the compute node, e.g. whether it has finished onboarding.",
+			},
+		},
+	}
+}
+
+func marshalEdgeCSPComputeNodeInput(d *schema.ResourceData) *goaviatrix.EdgeCSPComputeNode {
+	edgeCSPComputeNode := &goaviatrix.EdgeCSPComputeNode{
+		AccountName:   d.Get("account_name").(string),
+		ProjectUuid:   d.Get("project_uuid").(string),
+		Name:          d.Get("name").(string),
+		SerialNumber:  d.Get("serial_number").(string),
+		Model:         d.Get("model").(string),
+		OnboardingKey: d.Get("onboarding_key").(string),
+		Description:   d.Get("description").(string),
+		Uuid:          d.Get("uuid").(string),
+	}
+
+	for _, v := range d.Get("interfaces").(*schema.Set).List() {
+		edgeInterface := v.(map[string]interface{})
+		edgeCSPComputeNode.Interfaces = append(edgeCSPComputeNode.Interfaces, &goaviatrix.EdgeCSPComputeNodeInterface{
+			LogicalName:  edgeInterface["logical_name"].(string),
+			PhysicalName: edgeInterface["physical_name"].(string),
+			Type:         edgeInterface["type"].(string),
+		})
+	}
+
+	return edgeCSPComputeNode
+}
+
+func resourceAviatrixEdgeCSPComputeNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	edgeCSPComputeNode := marshalEdgeCSPComputeNodeInput(d)
+
+	if err := goaviatrix.ValidateEdgeCSPComputeNodeInterfaces(edgeCSPComputeNode.Interfaces); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating Edge CSP compute node %s with serial number %s", edgeCSPComputeNode.Name, edgeCSPComputeNode.SerialNumber)
+
+	uuid, err := client.CreateEdgeCSPComputeNode(ctx, edgeCSPComputeNode)
+	if err != nil {
+		return diag.Errorf("could not create Edge CSP compute node: %v", err)
+	}
+
+	d.SetId(edgeCSPComputeNode.AccountName + "~" + uuid)
+	return resourceAviatrixEdgeCSPComputeNodeRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeCSPComputeNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	parts := strings.Split(d.Id(), "~")
+	if len(parts) != 2 {
+		return diag.Errorf("invalid ID, expecting account_name~uuid, but received: %s", d.Id())
+	}
+
+	edgeCSPComputeNode, err := client.GetEdgeCSPComputeNode(ctx, parts[0], parts[1])
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read Edge CSP compute node: %v", err)
+	}
+
+	d.Set("account_name", edgeCSPComputeNode.AccountName)
+	d.Set("project_uuid", edgeCSPComputeNode.ProjectUuid)
+	d.Set("name", edgeCSPComputeNode.Name)
+	d.Set("serial_number", edgeCSPComputeNode.SerialNumber)
+	d.Set("model", edgeCSPComputeNode.Model)
+	d.Set("description", edgeCSPComputeNode.Description)
+	d.Set("uuid", edgeCSPComputeNode.Uuid)
+	d.Set("admin_state", edgeCSPComputeNode.AdminState)
+	d.Set("run_state", edgeCSPComputeNode.RunState)
+
+	var interfaces []map[string]interface{}
+	for _, edgeInterface := range edgeCSPComputeNode.Interfaces {
+		interfaces = append(interfaces, map[string]interface{}{
+			"logical_name":  edgeInterface.LogicalName,
+			"physical_name": edgeInterface.PhysicalName,
+			"type":          edgeInterface.Type,
+		})
+	}
+	if err := d.Set("interfaces", interfaces); err != nil {
+		return diag.Errorf("could not set interfaces into state: %v", err)
+	}
+
+	return nil
+}
+
+func resourceAviatrixEdgeCSPComputeNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChanges("description", "interfaces") {
+		edgeCSPComputeNode := marshalEdgeCSPComputeNodeInput(d)
+
+		if err := goaviatrix.ValidateEdgeCSPComputeNodeInterfaces(edgeCSPComputeNode.Interfaces); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := client.UpdateEdgeCSPComputeNode(ctx, edgeCSPComputeNode); err != nil {
+			return diag.Errorf("could not update Edge CSP compute node: %v", err)
+		}
+	}
+
+	return resourceAviatrixEdgeCSPComputeNodeRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeCSPComputeNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	err := client.DeleteEdgeCSPComputeNode(ctx, d.Get("account_name").(string), d.Get("uuid").(string))
+	if err != nil {
+		return diag.Errorf("could not delete Edge CSP compute node: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixEdgeCSPComputeNode_basic(t *testing.T) {
+	if os.Getenv("SKIP_EDGE_CSP_COMPUTE_NODE") == "yes" {
+		t.Skip("Skipping Edge CSP compute node test as SKIP_EDGE_CSP_COMPUTE_NODE is set")
+	}
+
+	resourceName := "aviatrix_edge_csp_compute_node.test"
+	accountName := "edge-csp-acc-" + acctest.RandString(5)
+	nodeName := "edge-csp-node-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEdgeCSPComputeNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeCSPComputeNodeBasic(accountName, nodeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEdgeCSPComputeNodeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", nodeName),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", os.Getenv("EDGE_CSP_SERIAL_NUMBER")),
+					resource.TestCheckResourceAttr(resourceName, "model", os.Getenv("EDGE_CSP_MODEL")),
+					resource.TestCheckResourceAttr(resourceName, "interfaces.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "interfaces.*", map[string]string{
+						"logical_name":  "eth0",
+						"physical_name": "eth0",
+						"type":          "WAN",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"onboarding_key"},
+			},
+		},
+	})
+}
+
+func testAccEdgeCSPComputeNodeBasic(accountName, nodeName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name      = "%s"
+	cloud_type        = 65536
+	edge_csp_username = "%s"
+	edge_csp_password = "%s"
+}
+resource "aviatrix_edge_csp_project" "test" {
+	account_name = aviatrix_account.test_account.account_name
+	name         = "%[4]s"
+}
+resource "aviatrix_edge_csp_compute_node" "test" {
+	account_name  = aviatrix_account.test_account.account_name
+	project_uuid  = aviatrix_edge_csp_project.test.uuid
+	name          = "%[4]s"
+	serial_number = "%[5]s"
+	model         = "%[6]s"
+
+	interfaces {
+		logical_name  = "eth0"
+		physical_name = "eth0"
+		type          = "WAN"
+	}
+
+	interfaces {
+		logical_name  = "eth1"
+		physical_name = "eth1"
+		type          = "LAN"
+	}
+}
+ `, accountName, os.Getenv("EDGE_CSP_USERNAME"), os.Getenv("EDGE_CSP_PASSWORD"), nodeName,
+		os.Getenv("EDGE_CSP_SERIAL_NUMBER"), os.Getenv("EDGE_CSP_MODEL"))
+}
+
+func testAccCheckEdgeCSPComputeNodeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("edge csp compute node not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no edge csp compute node id is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetEdgeCSPComputeNode(context.Background(), rs.Primary.Attributes["account_name"], rs.Primary.Attributes["uuid"])
+		if err != nil {
+			return fmt.Errorf("could not find edge csp compute node: %v", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckEdgeCSPComputeNodeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_edge_csp_compute_node" {
+			continue
+		}
+
+		_, err := client.GetEdgeCSPComputeNode(context.Background(), rs.Primary.Attributes["account_name"], rs.Primary.Attributes["uuid"])
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("edge csp compute node still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixEdgeCSPProject() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixEdgeCSPProjectCreate,
+		ReadWithoutTimeout:   resourceAviatrixEdgeCSPProjectRead,
+		UpdateWithoutTimeout: resourceAviatrixEdgeCSPProjectUpdate,
+		DeleteWithoutTimeout: resourceAviatrixEdgeCSPProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge CSP account name.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Edge CSP project name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Edge CSP project description.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edge CSP project UUID.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixEdgeCSPProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	edgeCSPProject := &goaviatrix.EdgeCSPProject{
+		AccountName: d.Get("account_name").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[INFO] Creating Edge CSP project: %#v", edgeCSPProject)
+
+	uuid, err := client.CreateEdgeCSPProject(ctx, edgeCSPProject)
+	if err != nil {
+		return diag.Errorf("could not create Edge CSP project: %v", err)
+	}
+
+	d.SetId(edgeCSPProject.AccountName + "~" + uuid)
+	return resourceAviatrixEdgeCSPProjectRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeCSPProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	parts := strings.Split(d.Id(), "~")
+	if len(parts) != 2 {
+		return diag.Errorf("invalid ID, expecting account_name~uuid, but received: %s", d.Id())
+	}
+
+	edgeCSPProject, err := client.GetEdgeCSPProject(ctx, parts[0], parts[1])
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read Edge CSP project: %v", err)
+	}
+
+	d.Set("account_name", edgeCSPProject.AccountName)
+	d.Set("name", edgeCSPProject.Name)
+	d.Set("description", edgeCSPProject.Description)
+	d.Set("uuid", edgeCSPProject.Uuid)
+
+	return nil
+}
+
+func resourceAviatrixEdgeCSPProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChange("description") {
+		edgeCSPProject := &goaviatrix.EdgeCSPProject{
+			AccountName: d.Get("account_name").(string),
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Uuid:        d.Get("uuid").(string),
+		}
+
+		if err := client.UpdateEdgeCSPProject(ctx, edgeCSPProject); err != nil {
+			return diag.Errorf("could not update description during Edge CSP project update: %v", err)
+		}
+	}
+
+	return resourceAviatrixEdgeCSPProjectRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeCSPProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	err := client.DeleteEdgeCSPProject(ctx, d.Get("account_name").(string), d.Get("uuid").(string))
+	if err != nil {
+		return diag.Errorf("could not delete Edge CSP project: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixEdgeCSPProject_basic(t *testing.T) {
+	if os.Getenv("SKIP_EDGE_CSP_PROJECT") == "yes" {
+		t.Skip("Skipping Edge CSP project test as SKIP_EDGE_CSP_PROJECT is set")
+	}
+
+	resourceName := "aviatrix_edge_csp_project.test"
+	accountName := "edge-csp-acc-" + acctest.RandString(5)
+	projectName := "edge-csp-project-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEdgeCSPProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeCSPProjectBasic(accountName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEdgeCSPProjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_name", accountName),
+					resource.TestCheckResourceAttr(resourceName, "name", projectName),
+					resource.TestCheckResourceAttr(resourceName, "description", "terraform acceptance test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEdgeCSPProjectBasic(accountName, projectName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name      = "%s"
+	cloud_type        = 65536
+	edge_csp_username = "%s"
+	edge_csp_password = "%s"
+}
+resource "aviatrix_edge_csp_project" "test" {
+	account_name = aviatrix_account.test_account.account_name
+	name         = "%s"
+	description  = "terraform acceptance test"
+}
+ `, accountName, os.Getenv("EDGE_CSP_USERNAME"), os.Getenv("EDGE_CSP_PASSWORD"), projectName)
+}
+
+func testAccCheckEdgeCSPProjectExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("edge csp project not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no edge csp project id is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetEdgeCSPProject(context.Background(), rs.Primary.Attributes["account_name"], rs.Primary.Attributes["uuid"])
+		if err != nil {
+			return fmt.Errorf("could not find edge csp project: %v", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckEdgeCSPProjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_edge_csp_project" {
+			continue
+		}
+
+		_, err := client.GetEdgeCSPProject(context.Background(), rs.Primary.Attributes["account_name"], rs.Primary.Attributes["uuid"])
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("edge csp project still exists")
+		}
+	}
+
+	return nil
+}
@@ -40,27 +40,48 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 				Description: "Site ID.",
 			},
 			"management_interface_config": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "Management interface configuration. Valid values: 'DHCP' and 'Static'.",
-				ValidateFunc: validation.StringInSlice([]string{"DHCP", "Static"}, false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"interfaces"},
+				Description:   "Management interface configuration. Valid values: 'DHCP' and 'Static'.",
+				ValidateFunc:  validation.StringInSlice([]string{"DHCP", "Static"}, false),
 			},
 			"wan_interface_ip_prefix": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "WAN interface IP/prefix.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"interfaces"},
+				Description:   "WAN interface IP/prefix.",
 			},
 			"wan_default_gateway_ip": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "WAN default gateway IP.",
-				ValidateFunc: validation.IsIPAddress,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"interfaces"},
+				Description:   "WAN default gateway IP.",
+				ValidateFunc:  validation.IsIPAddress,
 			},
 			"lan_interface_ip_prefix": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "LAN interface IP/prefix.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"interfaces"},
+				Description:   "LAN interface IP/prefix.",
+			},
+			"interfaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "WAN, LAN and MGMT interfaces.",
+				Elem:        edgeSpokeInterfaceSchema(),
+			},
+			"vlan": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"interfaces"},
+				Description:  "VLAN sub-interfaces of LAN interfaces.",
+				Elem:         edgeSpokeVlanSchema(),
 			},
 			"management_egress_ip_prefix": {
 				Type:        schema.TypeString,
@@ -110,12 +131,42 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the Edge as a CaaG ZTP file will be stored.",
+				Description: "The location where the Edge as a Spoke ZTP file will be stored. If not set, the ZTP file is returned in 'ztp_file_base64' instead.",
+			},
+			"ztp_file_upload_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "URL the ZTP file is uploaded to with an HTTP PUT request when the gateway is created, e.g. a pre-signed object storage URL.",
+			},
+			"ztp_file_upload_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "HTTP headers sent with the ZTP file upload request.",
+			},
+			"ztp_file_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded ZTP file. Only set if 'ztp_file_download_path' is not set.",
+			},
+			"ztp_file_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the ZTP file.",
 			},
 			"local_as_number": {
 				Type:         schema.TypeString,
@@ -240,6 +291,200 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 	}
 }
 
+func edgeSpokeInterfaceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Interface name.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"WAN", "LAN", "MGMT"}, false),
+				Description:  "Interface type. Valid values: 'WAN', 'LAN' and 'MGMT'.",
+			},
+			"enable_dhcp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable DHCP.",
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Interface static IP/prefix.",
+			},
+			"gateway_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Gateway IP.",
+			},
+			"wan_public_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Public IP of the WAN interface. Leave empty to have it discovered.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tag, e.g. the name of the circuit.",
+			},
+		},
+	}
+}
+
+func edgeSpokeVlanSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"parent_interface_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the parent LAN interface.",
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4094),
+				Description:  "VLAN ID.",
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "Sub-interface IP/prefix.",
+			},
+			"gateway_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "Gateway IP.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tag.",
+			},
+		},
+	}
+}
+
+func expandEdgeSpokeInterfaces(d *schema.ResourceData) ([]*goaviatrix.EdgeSpokeInterface, []*goaviatrix.EdgeSpokeVlan) {
+	var interfaces []*goaviatrix.EdgeSpokeInterface
+	var vlans []*goaviatrix.EdgeSpokeVlan
+
+	for _, v := range d.Get("interfaces").(*schema.Set).List() {
+		edgeInterface := v.(map[string]interface{})
+		interfaces = append(interfaces, &goaviatrix.EdgeSpokeInterface{
+			IfName:    edgeInterface["name"].(string),
+			Type:      edgeInterface["type"].(string),
+			Dhcp:      edgeInterface["enable_dhcp"].(bool),
+			IpAddr:    edgeInterface["ip_address"].(string),
+			GatewayIp: edgeInterface["gateway_ip"].(string),
+			PublicIp:  edgeInterface["wan_public_ip"].(string),
+			Tag:       edgeInterface["tag"].(string),
+		})
+	}
+
+	for _, v := range d.Get("vlan").(*schema.Set).List() {
+		vlan := v.(map[string]interface{})
+		vlans = append(vlans, &goaviatrix.EdgeSpokeVlan{
+			ParentInterface: vlan["parent_interface_name"].(string),
+			VlanId:          vlan["vlan_id"].(int),
+			IpAddr:          vlan["ip_address"].(string),
+			GatewayIp:       vlan["gateway_ip"].(string),
+			Tag:             vlan["tag"].(string),
+		})
+	}
+
+	return interfaces, vlans
+}
+
+func flattenEdgeSpokeInterfaces(interfaceList []*goaviatrix.EdgeSpokeInterface, vlanList []*goaviatrix.EdgeSpokeVlan) ([]map[string]interface{}, []map[string]interface{}) {
+	var interfaces []map[string]interface{}
+	for _, edgeInterface := range interfaceList {
+		interfaces = append(interfaces, map[string]interface{}{
+			"name":          edgeInterface.IfName,
+			"type":          edgeInterface.Type,
+			"enable_dhcp":   edgeInterface.Dhcp,
+			"ip_address":    edgeInterface.IpAddr,
+			"gateway_ip":    edgeInterface.GatewayIp,
+			"wan_public_ip": edgeInterface.PublicIp,
+			"tag":           edgeInterface.Tag,
+		})
+	}
+
+	var vlans []map[string]interface{}
+	for _, vlan := range vlanList {
+		vlans = append(vlans, map[string]interface{}{
+			"parent_interface_name": vlan.ParentInterface,
+			"vlan_id":               vlan.VlanId,
+			"ip_address":            vlan.IpAddr,
+			"gateway_ip":            vlan.GatewayIp,
+			"tag":                   vlan.Tag,
+		})
+	}
+	return interfaces, vlans
+}
+
+// edgeSpokeHasLegacyInterfaces reports whether the interfaces of the Edge as a Spoke can be represented by the
+// single interface arguments, i.e. one static WAN interface, one LAN interface, an optional MGMT interface and
+// no VLANs or tags.
+func edgeSpokeHasLegacyInterfaces(edgeSpoke *goaviatrix.EdgeSpoke) bool {
+	if len(edgeSpoke.VlanList) != 0 || edgeSpoke.WanInterfaceIpPrefix == "" || edgeSpoke.LanInterfaceIpPrefix == "" {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, edgeInterface := range edgeSpoke.InterfaceList {
+		counts[edgeInterface.Type]++
+		if edgeInterface.Tag != "" {
+			return false
+		}
+		switch edgeInterface.Type {
+		case "WAN":
+			if edgeInterface.Dhcp || edgeInterface.IpAddr != edgeSpoke.WanInterfaceIpPrefix {
+				return false
+			}
+		case "LAN":
+			if edgeInterface.IpAddr != edgeSpoke.LanInterfaceIpPrefix {
+				return false
+			}
+		case "MGMT":
+		default:
+			return false
+		}
+	}
+	return counts["WAN"] <= 1 && counts["LAN"] <= 1 && counts["MGMT"] <= 1
+}
+
+// clearUnsetEdgeSpokeInterfaceAddresses empties the addresses of the flattened interfaces that are not set
+// for that interface in the state. They are assigned by DHCP or discovered by the controller, and would
+// otherwise show a diff since the interfaces are a set.
+func clearUnsetEdgeSpokeInterfaceAddresses(d *schema.ResourceData, interfaces []map[string]interface{}) {
+	current := make(map[string]map[string]interface{})
+	for _, v := range d.Get("interfaces").(*schema.Set).List() {
+		edgeInterface := v.(map[string]interface{})
+		current[edgeInterface["name"].(string)] = edgeInterface
+	}
+
+	for _, edgeInterface := range interfaces {
+		c, ok := current[edgeInterface["name"].(string)]
+		if !ok {
+			continue
+		}
+		for _, k := range []string{"ip_address", "gateway_ip", "wan_public_ip"} {
+			if c[k].(string) == "" {
+				edgeInterface[k] = ""
+			}
+		}
+	}
+}
+
 func marshalEdgeSpokeInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke {
 	edgeSpoke := &goaviatrix.EdgeSpoke{
 		GwName:                             d.Get("gw_name").(string),
@@ -274,9 +519,23 @@ func marshalEdgeSpokeInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke {
 		RxQueueSize:                        d.Get("rx_queue_size").(string),
 	}
 
+	edgeSpoke.InterfaceList, edgeSpoke.VlanList = expandEdgeSpokeInterfaces(d)
+
 	return edgeSpoke
 }
 
+// validateEdgeSpokeInterfaceConfig checks that either 'interfaces' or the single WAN/LAN interface arguments are set.
+func validateEdgeSpokeInterfaceConfig(edgeSpoke *goaviatrix.EdgeSpoke) error {
+	if len(edgeSpoke.InterfaceList) != 0 {
+		return goaviatrix.ValidateEdgeSpokeInterfaces(edgeSpoke.InterfaceList, edgeSpoke.VlanList)
+	}
+	if edgeSpoke.ManagementInterfaceConfig == "" || edgeSpoke.WanInterfaceIpPrefix == "" ||
+		edgeSpoke.WanDefaultGatewayIp == "" || edgeSpoke.LanInterfaceIpPrefix == "" {
+		return fmt.Errorf("either 'interfaces' or 'management_interface_config', 'wan_interface_ip_prefix', 'wan_default_gateway_ip' and 'lan_interface_ip_prefix' are required")
+	}
+	return nil
+}
+
 func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
@@ -284,6 +543,10 @@ func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData
 	edgeSpoke := marshalEdgeSpokeInput(d)
 
 	// checks before creation
+	if err := validateEdgeSpokeInterfaceConfig(edgeSpoke); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if edgeSpoke.ManagementInterfaceConfig == "DHCP" && (edgeSpoke.ManagementInterfaceIpPrefix != "" || edgeSpoke.ManagementDefaultGatewayIp != "" ||
 		edgeSpoke.DnsServerIp != "" || edgeSpoke.SecondaryDnsServerIp != "") {
 		return diag.Errorf("'management_interface_ip', 'management_default_gateway_ip', 'dns_server_ip' and 'secondary_dns_server_ip' are only valid when 'management_interface_config' is Static")
@@ -329,6 +592,8 @@ func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("could not create Edge as a Spoke: %v", err)
 	}
 
+	diags := setEdgeZtpFile(ctx, d, edgeSpoke.ZtpFileContent)
+
 	// advanced configs
 	// use following variables to reuse functions for transit, spoke and gateway
 	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
@@ -435,7 +700,7 @@ func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	return resourceAviatrixEdgeSpokeReadIfRequired(ctx, d, meta, &flag)
+	return append(diags, resourceAviatrixEdgeSpokeReadIfRequired(ctx, d, meta, &flag)...)
 }
 
 func resourceAviatrixEdgeSpokeReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
@@ -450,7 +715,9 @@ func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*goaviatrix.Client)
 
 	// handle import
+	isImport := false
 	if d.Get("gw_name").(string) == "" {
+		isImport = true
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
 		d.Set("gw_name", id)
@@ -466,6 +733,18 @@ func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("could not read Edge as a Spoke: %v", err)
 	}
 
+	// on import, only set the interfaces if the single interface arguments can't represent them
+	if (isImport && !edgeSpokeHasLegacyInterfaces(edgeSpoke)) || d.Get("interfaces").(*schema.Set).Len() != 0 {
+		interfaces, vlans := flattenEdgeSpokeInterfaces(edgeSpoke.InterfaceList, edgeSpoke.VlanList)
+		clearUnsetEdgeSpokeInterfaceAddresses(d, interfaces)
+		if err := d.Set("interfaces", interfaces); err != nil {
+			return diag.Errorf("could not set interfaces into state: %v", err)
+		}
+		if err := d.Set("vlan", vlans); err != nil {
+			return diag.Errorf("could not set vlan into state: %v", err)
+		}
+	}
+
 	d.Set("gw_name", edgeSpoke.GwName)
 	d.Set("site_id", edgeSpoke.SiteId)
 	d.Set("enable_management_over_private_network", edgeSpoke.EnableManagementOverPrivateNetwork)
@@ -540,6 +819,12 @@ func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData
 	edgeSpoke := marshalEdgeSpokeInput(d)
 
 	// checks before update
+	if len(edgeSpoke.InterfaceList) != 0 {
+		if err := goaviatrix.ValidateEdgeSpokeInterfaces(edgeSpoke.InterfaceList, edgeSpoke.VlanList); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if !edgeSpoke.EnableLearnedCidrsApproval && len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
 		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
@@ -572,7 +857,8 @@ func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData
 		GwName: edgeSpoke.GwName,
 	}
 
-	if d.HasChanges("management_egress_ip_prefix", "wan_interface_ip_prefix", "wan_default_gateway_ip", "lan_interface_ip_prefix", "wan_public_ip") {
+	if d.HasChanges("management_egress_ip_prefix", "wan_interface_ip_prefix", "wan_default_gateway_ip", "lan_interface_ip_prefix", "wan_public_ip",
+		"interfaces", "vlan") {
 		err := client.UpdateEdgeSpokeIpConfigurations(ctx, edgeSpoke)
 		if err != nil {
 			return diag.Errorf("could not update IP configurations during Edge as a Spoke update: %v", err)
@@ -724,16 +1010,11 @@ func resourceAviatrixEdgeSpokeDelete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("could not delete Edge as a Spoke: %v", err)
 	}
 
-	var fileName string
-	if ztpFileType == "iso" {
-		fileName = ztpFileDownloadPath + "/" + gwName + "-" + siteId + ".iso"
-	} else {
-		fileName = ztpFileDownloadPath + "/" + gwName + "-" + siteId + "-cloud-init.txt"
-	}
-
-	err = os.Remove(fileName)
-	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+	if ztpFileDownloadPath != "" {
+		err = os.Remove(ztpFileDownloadPath + "/" + goaviatrix.EdgeZtpFileName(gwName+"-"+siteId, ztpFileType))
+		if err != nil {
+			log.Printf("[WARN] could not remove the ztp file: %v", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,254 @@
+package aviatrix
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixEdgeSpokeHa() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixEdgeSpokeHaCreate,
+		ReadWithoutTimeout:   resourceAviatrixEdgeSpokeHaRead,
+		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeHaUpdate,
+		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeHaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"primary_gw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the primary Edge as a Spoke.",
+			},
+			"interfaces": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "WAN, LAN and MGMT interfaces.",
+				Elem:        edgeSpokeInterfaceSchema(),
+			},
+			"vlan": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "VLAN sub-interfaces of LAN interfaces.",
+				Elem:        edgeSpokeVlanSchema(),
+			},
+			"management_egress_ip_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Management egress gateway IP/prefix.",
+			},
+			"enable_management_over_private_network": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Enable management over private network.",
+			},
+			"dns_server_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "DNS server IP.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"secondary_dns_server_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Secondary DNS server IP.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"ztp_file_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ZTP file type.",
+				ValidateFunc: validation.StringInSlice([]string{"iso", "cloud-init"}, false),
+			},
+			"ztp_file_download_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != ""
+				},
+				Description: "The location where the Edge as a Spoke HA ZTP file will be stored. If not set, the ZTP file is returned in 'ztp_file_base64' instead.",
+			},
+			"ztp_file_upload_url": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "URL the ZTP file is uploaded to with an HTTP PUT request when the gateway is created, e.g. a pre-signed object storage URL.",
+			},
+			"ztp_file_upload_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+				Description: "HTTP headers sent with the ZTP file upload request.",
+			},
+			"ztp_file_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded ZTP file. Only set if 'ztp_file_download_path' is not set.",
+			},
+			"ztp_file_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the ZTP file.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edge as a Spoke HA name.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Site ID.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of Edge as a Spoke HA.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixEdgeSpokeHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	edgeSpokeHa := &goaviatrix.EdgeSpokeHa{
+		PrimaryGwName:                      d.Get("primary_gw_name").(string),
+		ManagementEgressIpPrefix:           d.Get("management_egress_ip_prefix").(string),
+		EnableManagementOverPrivateNetwork: d.Get("enable_management_over_private_network").(bool),
+		DnsServerIp:                        d.Get("dns_server_ip").(string),
+		SecondaryDnsServerIp:               d.Get("secondary_dns_server_ip").(string),
+		ZtpFileType:                        d.Get("ztp_file_type").(string),
+		ZtpFileDownloadPath:                d.Get("ztp_file_download_path").(string),
+	}
+	edgeSpokeHa.InterfaceList, edgeSpokeHa.VlanList = expandEdgeSpokeInterfaces(d)
+
+	if err := goaviatrix.ValidateEdgeSpokeInterfaces(edgeSpokeHa.InterfaceList, edgeSpokeHa.VlanList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	primary, err := client.GetEdgeSpoke(ctx, edgeSpokeHa.PrimaryGwName)
+	if err != nil {
+		return diag.Errorf("could not find primary Edge as a Spoke %s: %v", edgeSpokeHa.PrimaryGwName, err)
+	}
+	if !primary.EnableEdgeActiveStandby {
+		log.Printf("[WARN] Edge Active-Standby is not enabled on %s, both Edge gateways will be active", edgeSpokeHa.PrimaryGwName)
+	}
+
+	haGwName, err := client.CreateEdgeSpokeHa(ctx, edgeSpokeHa)
+	if err != nil {
+		return diag.Errorf("could not create Edge as a Spoke HA: %v", err)
+	}
+	d.SetId(haGwName)
+
+	diags := setEdgeZtpFile(ctx, d, edgeSpokeHa.ZtpFileContent)
+	return append(diags, resourceAviatrixEdgeSpokeHaRead(ctx, d, meta)...)
+}
+
+func resourceAviatrixEdgeSpokeHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	// handle import
+	if d.Get("gw_name").(string) == "" {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		d.Set("gw_name", id)
+		d.SetId(id)
+	}
+
+	edgeSpokeHa, err := client.GetEdgeSpoke(ctx, d.Get("gw_name").(string))
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read Edge as a Spoke HA: %v", err)
+	}
+
+	d.Set("gw_name", edgeSpokeHa.GwName)
+	d.Set("primary_gw_name", edgeSpokeHa.PrimaryGwName)
+	d.Set("site_id", edgeSpokeHa.SiteId)
+	d.Set("management_egress_ip_prefix", edgeSpokeHa.ManagementEgressIpPrefix)
+	d.Set("enable_management_over_private_network", edgeSpokeHa.EnableManagementOverPrivateNetwork)
+	d.Set("dns_server_ip", edgeSpokeHa.DnsServerIp)
+	d.Set("secondary_dns_server_ip", edgeSpokeHa.SecondaryDnsServerIp)
+	d.Set("state", edgeSpokeHa.State)
+
+	interfaces, vlans := flattenEdgeSpokeInterfaces(edgeSpokeHa.InterfaceList, edgeSpokeHa.VlanList)
+	clearUnsetEdgeSpokeInterfaceAddresses(d, interfaces)
+	if err := d.Set("interfaces", interfaces); err != nil {
+		return diag.Errorf("could not set interfaces into state: %v", err)
+	}
+	if err := d.Set("vlan", vlans); err != nil {
+		return diag.Errorf("could not set vlan into state: %v", err)
+	}
+
+	d.SetId(edgeSpokeHa.GwName)
+	return nil
+}
+
+func resourceAviatrixEdgeSpokeHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	edgeSpoke := &goaviatrix.EdgeSpoke{
+		GwName:                   d.Get("gw_name").(string),
+		ManagementEgressIpPrefix: d.Get("management_egress_ip_prefix").(string),
+	}
+	edgeSpoke.InterfaceList, edgeSpoke.VlanList = expandEdgeSpokeInterfaces(d)
+
+	if err := goaviatrix.ValidateEdgeSpokeInterfaces(edgeSpoke.InterfaceList, edgeSpoke.VlanList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("management_egress_ip_prefix", "interfaces", "vlan") {
+		err := client.UpdateEdgeSpokeIpConfigurations(ctx, edgeSpoke)
+		if err != nil {
+			return diag.Errorf("could not update IP configurations during Edge as a Spoke HA update: %v", err)
+		}
+	}
+
+	return resourceAviatrixEdgeSpokeHaRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeSpokeHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	ztpFileDownloadPath := d.Get("ztp_file_download_path").(string)
+
+	err := client.DeleteEdgeSpoke(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("could not delete Edge as a Spoke HA: %v", err)
+	}
+
+	if ztpFileDownloadPath != "" {
+		err = os.Remove(ztpFileDownloadPath + "/" + goaviatrix.EdgeZtpFileName(gwName, d.Get("ztp_file_type").(string)))
+		if err != nil {
+			log.Printf("[WARN] could not remove the ztp file: %v", err)
+		}
+	}
+
+	return nil
+}
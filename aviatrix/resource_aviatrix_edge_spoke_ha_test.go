@@ -0,0 +1,145 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixEdgeSpokeHa_basic(t *testing.T) {
+	if os.Getenv("SKIP_EDGE_SPOKE_HA") == "yes" {
+		t.Skip("Skipping Edge as a Spoke HA test as SKIP_EDGE_SPOKE_HA is set")
+	}
+
+	resourceName := "aviatrix_edge_spoke_ha.test"
+	gwName := "edge-" + acctest.RandString(5)
+	siteId := "site-" + acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEdgeSpokeHaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeSpokeHaBasic(gwName, siteId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEdgeSpokeHaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "primary_gw_name", gwName),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", gwName+"-hagw"),
+					resource.TestCheckResourceAttr(resourceName, "site_id", siteId),
+					resource.TestCheckResourceAttr(resourceName, "interfaces.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "ztp_file_sha256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path", "ztp_file_base64", "ztp_file_sha256",
+					"ztp_file_upload_url", "ztp_file_upload_headers"},
+			},
+		},
+	})
+}
+
+func testAccEdgeSpokeHaBasic(gwName, siteId string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_edge_spoke" "test" {
+	gw_name                    = "%s"
+	site_id                    = "%s"
+	ztp_file_type              = "cloud-init"
+	enable_edge_active_standby = true
+
+	interfaces {
+		name       = "eth0"
+		type       = "WAN"
+		ip_address = "10.60.0.2/24"
+		gateway_ip = "10.60.0.1"
+	}
+
+	interfaces {
+		name       = "eth1"
+		type       = "LAN"
+		ip_address = "10.70.0.2/24"
+	}
+
+	interfaces {
+		name        = "eth2"
+		type        = "MGMT"
+		enable_dhcp = true
+	}
+}
+
+resource "aviatrix_edge_spoke_ha" "test" {
+	primary_gw_name = aviatrix_edge_spoke.test.gw_name
+	ztp_file_type   = "cloud-init"
+
+	interfaces {
+		name       = "eth0"
+		type       = "WAN"
+		ip_address = "10.60.0.3/24"
+		gateway_ip = "10.60.0.1"
+	}
+
+	interfaces {
+		name       = "eth1"
+		type       = "LAN"
+		ip_address = "10.70.0.3/24"
+	}
+
+	interfaces {
+		name        = "eth2"
+		type        = "MGMT"
+		enable_dhcp = true
+	}
+}
+  `, gwName, siteId)
+}
+
+func testAccCheckEdgeSpokeHaExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("edge as a spoke ha not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no edge as a spoke ha id is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		edgeSpokeHa, err := client.GetEdgeSpoke(context.Background(), rs.Primary.Attributes["gw_name"])
+		if err != nil {
+			return err
+		}
+		if edgeSpokeHa.GwName != rs.Primary.ID {
+			return fmt.Errorf("could not find edge as a spoke ha")
+		}
+		return nil
+	}
+}
+
+func testAccCheckEdgeSpokeHaDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_edge_spoke_ha" {
+			continue
+		}
+
+		_, err := client.GetEdgeSpoke(context.Background(), rs.Primary.Attributes["gw_name"])
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("edge as a spoke ha still exists")
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -46,7 +47,7 @@ func TestAccAviatrixEdgeSpoke_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path", "ztp_file_base64", "ztp_file_sha256"},
 			},
 		},
 	})
@@ -106,3 +107,114 @@ func testAccCheckEdgeSpokeDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestClearUnsetEdgeSpokeInterfaceAddresses(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAviatrixEdgeSpoke().Schema, map[string]interface{}{
+		"interfaces": []interface{}{
+			map[string]interface{}{
+				"name":        "eth0",
+				"type":        "WAN",
+				"enable_dhcp": true,
+			},
+			map[string]interface{}{
+				"name":       "eth1",
+				"type":       "LAN",
+				"ip_address": "10.1.0.1/24",
+			},
+		},
+	})
+
+	interfaces, _ := flattenEdgeSpokeInterfaces([]*goaviatrix.EdgeSpokeInterface{
+		{IfName: "eth0", Type: "WAN", Dhcp: true, IpAddr: "192.168.1.10/24", GatewayIp: "192.168.1.1", PublicIp: "203.0.113.10"},
+		{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24", GatewayIp: "10.1.0.254"},
+		{IfName: "eth2", Type: "MGMT", Dhcp: true, IpAddr: "172.16.0.10/24", GatewayIp: "172.16.0.1"},
+	}, nil)
+	clearUnsetEdgeSpokeInterfaceAddresses(d, interfaces)
+
+	expected := []map[string]string{
+		{"ip_address": "", "gateway_ip": "", "wan_public_ip": ""},
+		{"ip_address": "10.1.0.1/24", "gateway_ip": "", "wan_public_ip": ""},
+		{"ip_address": "172.16.0.10/24", "gateway_ip": "172.16.0.1", "wan_public_ip": ""},
+	}
+	for i, edgeInterface := range interfaces {
+		for k, v := range expected[i] {
+			if edgeInterface[k] != v {
+				t.Errorf("interface %s: expected %s to be %q, got %q", edgeInterface["name"], k, v, edgeInterface[k])
+			}
+		}
+	}
+}
+
+func TestEdgeSpokeHasLegacyInterfaces(t *testing.T) {
+	tt := []struct {
+		Name      string
+		EdgeSpoke goaviatrix.EdgeSpoke
+		Expected  bool
+	}{
+		{
+			Name: "legacy",
+			EdgeSpoke: goaviatrix.EdgeSpoke{
+				WanInterfaceIpPrefix: "192.168.1.10/24",
+				LanInterfaceIpPrefix: "10.1.0.1/24",
+				InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+					{IfName: "eth0", Type: "WAN", IpAddr: "192.168.1.10/24", GatewayIp: "192.168.1.1", PublicIp: "203.0.113.10"},
+					{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24"},
+					{IfName: "eth2", Type: "MGMT", Dhcp: true},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "second WAN interface",
+			EdgeSpoke: goaviatrix.EdgeSpoke{
+				WanInterfaceIpPrefix: "192.168.1.10/24",
+				LanInterfaceIpPrefix: "10.1.0.1/24",
+				InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+					{IfName: "eth0", Type: "WAN", IpAddr: "192.168.1.10/24"},
+					{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24"},
+					{IfName: "eth3", Type: "WAN", IpAddr: "192.168.2.10/24"},
+				},
+			},
+		},
+		{
+			Name: "DHCP WAN interface",
+			EdgeSpoke: goaviatrix.EdgeSpoke{
+				WanInterfaceIpPrefix: "192.168.1.10/24",
+				LanInterfaceIpPrefix: "10.1.0.1/24",
+				InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+					{IfName: "eth0", Type: "WAN", Dhcp: true, IpAddr: "192.168.1.10/24"},
+					{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24"},
+				},
+			},
+		},
+		{
+			Name: "VLAN",
+			EdgeSpoke: goaviatrix.EdgeSpoke{
+				WanInterfaceIpPrefix: "192.168.1.10/24",
+				LanInterfaceIpPrefix: "10.1.0.1/24",
+				InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+					{IfName: "eth0", Type: "WAN", IpAddr: "192.168.1.10/24"},
+					{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24"},
+				},
+				VlanList: []*goaviatrix.EdgeSpokeVlan{{ParentInterface: "eth1", VlanId: 10, IpAddr: "10.2.0.1/24"}},
+			},
+		},
+		{
+			Name: "no legacy fields",
+			EdgeSpoke: goaviatrix.EdgeSpoke{
+				InterfaceList: []*goaviatrix.EdgeSpokeInterface{
+					{IfName: "eth0", Type: "WAN", IpAddr: "192.168.1.10/24"},
+					{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.1/24"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			if legacy := edgeSpokeHasLegacyInterfaces(&test.EdgeSpoke); legacy != test.Expected {
+				t.Errorf("expected %t, got %t", test.Expected, legacy)
+			}
+		})
+	}
+}
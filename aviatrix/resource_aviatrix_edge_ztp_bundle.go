@@ -0,0 +1,180 @@
+package aviatrix
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixEdgeZtpBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixEdgeZtpBundleCreate,
+		ReadWithoutTimeout:   resourceAviatrixEdgeZtpBundleRead,
+		DeleteWithoutTimeout: resourceAviatrixEdgeZtpBundleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Edge as a Spoke or Edge as a CaaG gateway.",
+			},
+			"ztp_file_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ZTP file type.",
+				ValidateFunc: validation.StringInSlice([]string{"iso", "cloud-init"}, false),
+			},
+			"ztp_file_download_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is returned in 'ztp_file_base64' instead.",
+			},
+			"ztp_file_upload_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "URL the ZTP file is uploaded to with an HTTP PUT request, e.g. a pre-signed object storage URL.",
+			},
+			"ztp_file_upload_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "HTTP headers sent with the ZTP file upload request.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will regenerate the ZTP file.",
+			},
+			"ztp_file_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded ZTP file. Only set if 'ztp_file_download_path' is not set.",
+			},
+			"ztp_file_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the ZTP file.",
+			},
+		},
+	}
+}
+
+func getEdgeZtpUploadHeaders(d *schema.ResourceData) map[string]string {
+	headers := make(map[string]string)
+	for k, v := range d.Get("ztp_file_upload_headers").(map[string]interface{}) {
+		headers[k] = v.(string)
+	}
+	return headers
+}
+
+// setEdgeZtpFile stores the ZTP file of an Edge gateway in the state and uploads it if
+// 'ztp_file_upload_url' is set. A failed upload is returned as a warning, so that the gateway
+// is not replaced because of it.
+func setEdgeZtpFile(ctx context.Context, d *schema.ResourceData, content []byte) diag.Diagnostics {
+	if d.Get("ztp_file_download_path").(string) == "" {
+		d.Set("ztp_file_base64", base64.StdEncoding.EncodeToString(content))
+	}
+	d.Set("ztp_file_sha256", goaviatrix.EdgeZtpFileSha256(content))
+
+	url := d.Get("ztp_file_upload_url").(string)
+	if url == "" {
+		return nil
+	}
+	if err := goaviatrix.UploadEdgeZtpFile(ctx, url, getEdgeZtpUploadHeaders(d), content); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Failed to upload the ZTP file",
+				Detail:   fmt.Sprintf("%v. Use the aviatrix_edge_ztp_bundle resource to upload it again.", err),
+			},
+		}
+	}
+	return nil
+}
+
+// edgeZtpBundleFileName returns the name of the ZTP file written by this resource. It differs from the
+// name used by the Edge gateway resources, so that deleting the bundle doesn't remove their ZTP file.
+func edgeZtpBundleFileName(gwName, ztpFileType string) string {
+	return goaviatrix.EdgeZtpFileName(gwName+"-bundle", ztpFileType)
+}
+
+func resourceAviatrixEdgeZtpBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	ztpFileType := d.Get("ztp_file_type").(string)
+	ztpFileDownloadPath := d.Get("ztp_file_download_path").(string)
+
+	content, err := client.GetEdgeZtpFile(ctx, gwName, ztpFileType, ztpFileDownloadPath, edgeZtpBundleFileName(gwName, ztpFileType))
+	if err != nil {
+		return diag.Errorf("could not generate ZTP file for %s: %v", gwName, err)
+	}
+
+	d.SetId(gwName)
+
+	if d.Get("ztp_file_upload_url").(string) != "" {
+		if err := goaviatrix.UploadEdgeZtpFile(ctx, d.Get("ztp_file_upload_url").(string), getEdgeZtpUploadHeaders(d), content); err != nil {
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+	}
+
+	if ztpFileDownloadPath == "" {
+		d.Set("ztp_file_base64", base64.StdEncoding.EncodeToString(content))
+	}
+	d.Set("ztp_file_sha256", goaviatrix.EdgeZtpFileSha256(content))
+
+	return resourceAviatrixEdgeZtpBundleRead(ctx, d, meta)
+}
+
+func resourceAviatrixEdgeZtpBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+
+	_, err := client.GetEdgeSpoke(ctx, gwName)
+	if err == goaviatrix.ErrNotFound {
+		_, err = client.GetEdgeCaag(ctx, gwName)
+	}
+	if err == goaviatrix.ErrNotFound {
+		log.Printf("[WARN] Edge gateway %s of the ZTP bundle no longer exists", gwName)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find Edge gateway %s: %v", gwName, err)
+	}
+
+	return nil
+}
+
+func resourceAviatrixEdgeZtpBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gwName := d.Get("gw_name").(string)
+	ztpFileDownloadPath := d.Get("ztp_file_download_path").(string)
+
+	if ztpFileDownloadPath != "" {
+		err := os.Remove(ztpFileDownloadPath + "/" + edgeZtpBundleFileName(gwName, d.Get("ztp_file_type").(string)))
+		if err != nil {
+			log.Printf("[WARN] could not remove the ztp file: %v", err)
+		}
+	}
+
+	return nil
+}
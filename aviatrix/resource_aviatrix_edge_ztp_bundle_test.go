@@ -0,0 +1,67 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixEdgeZtpBundle_basic(t *testing.T) {
+	resourceName := "aviatrix_edge_ztp_bundle.test"
+
+	skipAcc := os.Getenv("SKIP_EDGE_ZTP_BUNDLE")
+	if skipAcc == "yes" {
+		t.Skip("Skipping Edge ZTP bundle tests as 'SKIP_EDGE_ZTP_BUNDLE' is set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preEdgeZtpBundleCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEdgeZtpBundleConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEdgeZtpBundleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", os.Getenv("EDGE_SPOKE_NAME")),
+					resource.TestCheckResourceAttrSet(resourceName, "ztp_file_base64"),
+					resource.TestCheckResourceAttrSet(resourceName, "ztp_file_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func preEdgeZtpBundleCheck(t *testing.T) {
+	if os.Getenv("EDGE_SPOKE_NAME") == "" {
+		t.Fatal("Environment variable EDGE_SPOKE_NAME is not set")
+	}
+}
+
+func testAccEdgeZtpBundleConfigBasic() string {
+	return fmt.Sprintf(`
+resource "aviatrix_edge_ztp_bundle" "test" {
+	gw_name       = "%s"
+	ztp_file_type = "cloud-init"
+}
+	`, os.Getenv("EDGE_SPOKE_NAME"))
+}
+
+func testAccCheckEdgeZtpBundleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("edge ztp bundle not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no edge ztp bundle ID is set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,354 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixFireNetFirewallRouteSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixFireNetFirewallRouteSyncCreate,
+		ReadWithoutTimeout:   resourceAviatrixFireNetFirewallRouteSyncRead,
+		UpdateWithoutTimeout: resourceAviatrixFireNetFirewallRouteSyncUpdate,
+		DeleteWithoutTimeout: resourceAviatrixFireNetFirewallRouteSyncDelete,
+		CustomizeDiff:        resourceAviatrixFireNetFirewallRouteSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "FireNet VPC ID.",
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "gateway_name"},
+				Description:  "ID of the firewall instance to sync routes to.",
+			},
+			"gateway_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the FireNet gateway whose firewall manager routes are synced to.",
+			},
+			"vendor_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Generic", "Palo Alto Networks VM-Series", "Aviatrix FQDN Gateway", "Fortinet FortiGate",
+					"Check Point Cloud Guard", "Palo Alto Networks Panorama",
+				}, false),
+				Description: "Vendor type.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IP address of the firewall or firewall manager management interface for API calls from the Aviatrix Controller.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Login name for API calls from the Controller.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Login password for API calls from the Controller.",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "API token for Fortinet FortiGate.",
+			},
+			"private_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Private key file for Check Point Cloud Guard.",
+			},
+			"firewall_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the firewall instance.",
+			},
+			"route_table": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the firewall virtual router to program.",
+			},
+			"template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Panorama template for the FireNet gateway.",
+			},
+			"template_stack": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Panorama template stack for the FireNet gateway.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will trigger a route sync.",
+			},
+			"number_of_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of retries for saving the vendor info and syncing routes.",
+			},
+			"retry_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Retry interval in seconds.",
+			},
+			"synced_routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Routes last synced to the firewall.",
+			},
+			"in_sync": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the routes on the firewall match the routes last synced by the Controller.",
+			},
+		},
+	}
+}
+
+func marshalFireNetFirewallRouteSyncInput(d *schema.ResourceData) (*goaviatrix.VendorInfo, *goaviatrix.FirewallManager) {
+	if instanceID := d.Get("instance_id").(string); instanceID != "" {
+		return &goaviatrix.VendorInfo{
+			VpcID:          d.Get("vpc_id").(string),
+			InstanceID:     instanceID,
+			FirewallName:   d.Get("firewall_name").(string),
+			VendorType:     d.Get("vendor_type").(string),
+			Username:       d.Get("username").(string),
+			Password:       d.Get("password").(string),
+			ApiToken:       d.Get("api_token").(string),
+			PrivateKeyFile: d.Get("private_key_file").(string),
+			RouteTable:     d.Get("route_table").(string),
+			PublicIP:       d.Get("public_ip").(string),
+		}, nil
+	}
+	return nil, &goaviatrix.FirewallManager{
+		VpcID:         d.Get("vpc_id").(string),
+		GatewayName:   d.Get("gateway_name").(string),
+		VendorType:    d.Get("vendor_type").(string),
+		PublicIP:      d.Get("public_ip").(string),
+		Username:      d.Get("username").(string),
+		Password:      d.Get("password").(string),
+		Template:      d.Get("template").(string),
+		TemplateStack: d.Get("template_stack").(string),
+		RouteTable:    d.Get("route_table").(string),
+	}
+}
+
+func validateFireNetFirewallRouteSync(vendorInfo *goaviatrix.VendorInfo, firewallManager *goaviatrix.FirewallManager) error {
+	if firewallManager != nil {
+		switch firewallManager.VendorType {
+		case "Generic":
+		case "Palo Alto Networks Panorama":
+			if firewallManager.PublicIP == "" || firewallManager.Username == "" || firewallManager.Password == "" ||
+				firewallManager.Template == "" || firewallManager.TemplateStack == "" {
+				return fmt.Errorf("'public_ip', 'username', 'password', 'template' and 'template_stack' are required for vendor type 'Palo Alto Networks Panorama'")
+			}
+		default:
+			return fmt.Errorf("vendor type %q is not supported with 'gateway_name', valid values are 'Generic' and 'Palo Alto Networks Panorama'", firewallManager.VendorType)
+		}
+		return nil
+	}
+
+	switch vendorInfo.VendorType {
+	case "Palo Alto Networks Panorama":
+		return fmt.Errorf("vendor type 'Palo Alto Networks Panorama' requires 'gateway_name' instead of 'instance_id'")
+	case "Fortinet FortiGate":
+		if vendorInfo.ApiToken == "" {
+			return fmt.Errorf("'api_token' is required for vendor type 'Fortinet FortiGate'")
+		}
+	case "Check Point Cloud Guard":
+		if vendorInfo.PrivateKeyFile != "" {
+			if vendorInfo.Password != "" {
+				return fmt.Errorf("'password' should be empty when using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
+			}
+		} else if vendorInfo.Username == "" || vendorInfo.Password == "" {
+			return fmt.Errorf("'username' and 'password' are required when not using 'private_key_file' for vendor type 'Check Point Cloud Guard'")
+		}
+	default:
+		if vendorInfo.Username == "" || vendorInfo.Password == "" {
+			return fmt.Errorf("'username' and 'password' are required for vendor type %q", vendorInfo.VendorType)
+		}
+		if vendorInfo.ApiToken != "" {
+			return fmt.Errorf("'api_token' is valid only for vendor type 'Fortinet FortiGate'")
+		}
+		if vendorInfo.PrivateKeyFile != "" {
+			return fmt.Errorf("'private_key_file' is valid only for vendor type 'Check Point Cloud Guard'")
+		}
+	}
+	return nil
+}
+
+func retryFireNetFirewallRouteSync(numberOfRetries, retryInterval int, f func() error) error {
+	for i := 0; ; i++ {
+		err := f()
+		if err == nil || i >= numberOfRetries {
+			return err
+		}
+		log.Printf("[DEBUG] FireNet firewall route sync failed, retrying in %d seconds: %v", retryInterval, err)
+		time.Sleep(time.Duration(retryInterval) * time.Second)
+	}
+}
+
+func syncFireNetFirewallRoutes(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) error {
+	vendorInfo, firewallManager := marshalFireNetFirewallRouteSyncInput(d)
+	if err := validateFireNetFirewallRouteSync(vendorInfo, firewallManager); err != nil {
+		return err
+	}
+
+	numberOfRetries := d.Get("number_of_retries").(int)
+	retryInterval := d.Get("retry_interval").(int)
+
+	if firewallManager != nil {
+		err := retryFireNetFirewallRouteSync(numberOfRetries, retryInterval, func() error {
+			return client.EditFireNetFirewallManagerVendorInfo(ctx, firewallManager)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save FireNet firewall manager vendor info: %v", err)
+		}
+		err = retryFireNetFirewallRouteSync(numberOfRetries, retryInterval, func() error {
+			return client.SyncFireNetFirewallManagerVendorConfig(ctx, firewallManager)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to sync FireNet firewall manager routes: %v", err)
+		}
+		return nil
+	}
+
+	if vendorInfo.PublicIP == "" || vendorInfo.FirewallName == "" {
+		fI, err := client.GetFirewallInstance(&goaviatrix.FirewallInstance{InstanceID: vendorInfo.InstanceID})
+		if err != nil {
+			return fmt.Errorf("couldn't find firewall instance %s: %v", vendorInfo.InstanceID, err)
+		}
+		if vendorInfo.PublicIP == "" {
+			vendorInfo.PublicIP = fI.ManagementPublicIP
+		}
+		if vendorInfo.FirewallName == "" {
+			vendorInfo.FirewallName = fI.FirewallName
+		}
+	}
+
+	err := retryFireNetFirewallRouteSync(numberOfRetries, retryInterval, func() error {
+		if vendorInfo.VendorType == "Check Point Cloud Guard" && vendorInfo.PrivateKeyFile != "" {
+			return client.EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo)
+		}
+		return client.EditFireNetFirewallVendorInfo(vendorInfo)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save FireNet firewall vendor info: %v", err)
+	}
+	err = retryFireNetFirewallRouteSync(numberOfRetries, retryInterval, func() error {
+		return client.ShowFireNetFirewallVendorConfig(vendorInfo)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync FireNet firewall routes: %v", err)
+	}
+	return nil
+}
+
+func resourceAviatrixFireNetFirewallRouteSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if err := syncFireNetFirewallRoutes(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if instanceID := d.Get("instance_id").(string); instanceID != "" {
+		d.SetId(d.Get("vpc_id").(string) + "~~" + instanceID)
+	} else {
+		d.SetId(d.Get("vpc_id").(string) + "~~" + d.Get("gateway_name").(string))
+	}
+	return resourceAviatrixFireNetFirewallRouteSyncRead(ctx, d, meta)
+}
+
+func resourceAviatrixFireNetFirewallRouteSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpcID := d.Get("vpc_id").(string)
+	instanceID := d.Get("instance_id").(string)
+	status, err := client.GetFireNetFirewallRouteStatus(ctx, vpcID, instanceID, d.Get("gateway_name").(string))
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not get FireNet firewall route sync status: %v", err)
+	}
+
+	if instanceID != "" {
+		fI, err := client.GetFirewallInstance(&goaviatrix.FirewallInstance{InstanceID: instanceID})
+		if err == goaviatrix.ErrNotFound {
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return diag.Errorf("couldn't find firewall instance %s: %v", instanceID, err)
+		}
+		if d.Get("public_ip").(string) == "" {
+			d.Set("public_ip", fI.ManagementPublicIP)
+		}
+		if d.Get("firewall_name").(string) == "" {
+			d.Set("firewall_name", fI.FirewallName)
+		}
+	}
+
+	if err := d.Set("synced_routes", status.Routes); err != nil {
+		return diag.Errorf("could not set synced_routes: %v", err)
+	}
+	d.Set("in_sync", status.InSync)
+	return nil
+}
+
+func resourceAviatrixFireNetFirewallRouteSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if err := syncFireNetFirewallRoutes(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAviatrixFireNetFirewallRouteSyncRead(ctx, d, meta)
+}
+
+func resourceAviatrixFireNetFirewallRouteSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceAviatrixFireNetFirewallRouteSyncCustomizeDiff plans a new sync when the routes on the firewall
+// drifted from the routes last synced by the Controller.
+func resourceAviatrixFireNetFirewallRouteSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("in_sync").(bool) {
+		return nil
+	}
+	log.Printf("[INFO] Routes on FireNet firewall %s are out of sync, planning a new route sync", d.Id())
+	if err := d.SetNewComputed("synced_routes"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("in_sync")
+}
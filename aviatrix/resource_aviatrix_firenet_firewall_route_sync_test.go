@@ -0,0 +1,130 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixFireNetFirewallRouteSync_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_firenet_firewall_route_sync.test"
+
+	skipAcc := os.Getenv("SKIP_FIRENET_FIREWALL_ROUTE_SYNC")
+	if skipAcc == "yes" {
+		t.Skip("Skipping FireNet Firewall Route Sync test as SKIP_FIRENET_FIREWALL_ROUTE_SYNC is set")
+	}
+	msg := ". Set SKIP_FIRENET_FIREWALL_ROUTE_SYNC to yes to skip FireNet Firewall Route Sync tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preAccountCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFireNetFirewallRouteSyncBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFireNetFirewallRouteSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vendor_type", "Generic"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_name", fmt.Sprintf("tffw-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "in_sync", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFireNetFirewallRouteSyncBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%[1]s"
+	cloud_type         = 1
+	aws_account_number = "%[2]s"
+	aws_iam            = false
+	aws_access_key     = "%[3]s"
+	aws_secret_key     = "%[4]s"
+}
+resource "aviatrix_vpc" "test_vpc" {
+	cloud_type           = 1
+	account_name         = aviatrix_account.test_account.account_name
+	region               = "%[5]s"
+	name                 = "vpc-for-firenet"
+	cidr                 = "10.10.0.0/24"
+	aviatrix_firenet_vpc = true
+}
+resource "aviatrix_transit_gateway" "test_transit_gateway" {
+	cloud_type               = aviatrix_vpc.test_vpc.cloud_type
+	account_name             = aviatrix_account.test_account.account_name
+	gw_name                  = "tftg-%[1]s"
+	vpc_id                   = aviatrix_vpc.test_vpc.vpc_id
+	vpc_reg                  = aviatrix_vpc.test_vpc.region
+	gw_size                  = "c5.xlarge"
+	subnet                   = aviatrix_vpc.test_vpc.subnets[0].cidr
+	enable_hybrid_connection = true
+	enable_firenet           = true
+}
+resource "aviatrix_firewall_instance" "test_firewall_instance" {
+	vpc_id            = aviatrix_vpc.test_vpc.vpc_id
+	firenet_gw_name   = aviatrix_transit_gateway.test_transit_gateway.gw_name
+	firewall_name     = "tffw-%[1]s"
+	firewall_image    = "Palo Alto Networks VM-Series Next-Generation Firewall Bundle 1"
+	firewall_size     = "m5.xlarge"
+	management_subnet = aviatrix_vpc.test_vpc.subnets[0].cidr
+	egress_subnet     = aviatrix_vpc.test_vpc.subnets[1].cidr
+}
+resource "aviatrix_firenet" "test_firenet" {
+	vpc_id             = aviatrix_vpc.test_vpc.vpc_id
+	inspection_enabled = true
+	egress_enabled     = false
+
+	firewall_instance_association {
+		firenet_gw_name      = aviatrix_transit_gateway.test_transit_gateway.gw_name
+		instance_id          = aviatrix_firewall_instance.test_firewall_instance.instance_id
+		firewall_name        = aviatrix_firewall_instance.test_firewall_instance.firewall_name
+		attached             = true
+		lan_interface        = aviatrix_firewall_instance.test_firewall_instance.lan_interface
+		management_interface = aviatrix_firewall_instance.test_firewall_instance.management_interface
+		egress_interface     = aviatrix_firewall_instance.test_firewall_instance.egress_interface
+	}
+}
+resource "aviatrix_firenet_firewall_route_sync" "test" {
+	vpc_id      = aviatrix_vpc.test_vpc.vpc_id
+	instance_id = aviatrix_firewall_instance.test_firewall_instance.instance_id
+	vendor_type = "Generic"
+	username    = "admin"
+	password    = "Avx123456#"
+
+	depends_on = [aviatrix_firenet.test_firenet]
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_REGION"))
+}
+
+func testAccCheckFireNetFirewallRouteSyncExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("firenet_firewall_route_sync Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no firenet_firewall_route_sync ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetFireNetFirewallRouteStatus(context.Background(), rs.Primary.Attributes["vpc_id"],
+			rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["gateway_name"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
@@ -1,10 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +19,7 @@ func resourceAviatrixFirewallInstanceAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAviatrixFirewallInstanceAssociationCreate,
 		Read:   resourceAviatrixFirewallInstanceAssociationRead,
+		Update: resourceAviatrixFirewallInstanceAssociationUpdate,
 		Delete: resourceAviatrixFirewallInstanceAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -83,6 +86,23 @@ func resourceAviatrixFirewallInstanceAssociation() *schema.Resource {
 				Default:     false,
 				Description: "Switch to attach/detach firewall instance to/from fireNet.",
 			},
+			"wait_for_ready": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Wait for the FireNet health check of the firewall instance to be up after the association is created.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of minutes to wait for the firewall instance to be ready.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -149,6 +169,17 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("wait_for_ready"); ok && firewall.VendorType != FQDNVendorType {
+		timeout := 20 * time.Minute
+		if waitForReady, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			timeout = time.Duration(waitForReady["timeout"].(int)) * time.Minute
+		}
+		err := client.WaitForFirewallInstanceReady(context.Background(), firewall.VpcID, firewall.InstanceID, timeout)
+		if err != nil {
+			return fmt.Errorf("failed to wait for firewall instance to be ready: %v", err)
+		}
+	}
+
 	return resourceAviatrixFirewallInstanceAssociationReadIfRequired(d, meta, &flag)
 }
 
@@ -236,6 +267,11 @@ func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, met
 	return nil
 }
 
+// wait_for_ready is the only argument that can be updated, it only applies when the association is created
+func resourceAviatrixFirewallInstanceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAviatrixFirewallInstanceAssociationRead(d, meta)
+}
+
 func resourceAviatrixFirewallInstanceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*goaviatrix.Client)
 
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -20,6 +21,8 @@ func resourceAviatrixFQDN() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAviatrixFQDNCustomizeDiff,
+
 		SchemaVersion: 2,
 		MigrateState:  resourceAviatrixFQDNMigrateState,
 		StateUpgraders: []schema.StateUpgrader{
@@ -98,6 +101,31 @@ func resourceAviatrixFQDN() *schema.Resource {
 					},
 				},
 			},
+			"domain_names_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"domain_names", "domain_names_url"},
+				Description: "Path to a file with one 'fqdn,protocol,port[,action]' domain name rule per line. " +
+					"Lines starting with '#' are ignored.",
+			},
+			"domain_names_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"domain_names", "domain_names_file"},
+				RequiredWith:  []string{"domain_names_url_sha256"},
+				Description:   "URL of a domain list feed in the same format as 'domain_names_file'.",
+			},
+			"domain_names_url_sha256": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"domain_names_url"},
+				Description:  "SHA-256 checksum the content of 'domain_names_url' must match.",
+			},
+			"domain_names_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the domain name rules set from 'domain_names_file' or 'domain_names_url'.",
+			},
 			"manage_domain_names": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -114,6 +142,8 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
+	domainNamesFile := d.Get("domain_names_file").(string)
+	domainNamesURL := d.Get("domain_names_url").(string)
 	if hasSetDomainNames && !enabledInlineDomainNames {
 		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
 	}
@@ -161,7 +191,21 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 				fqdn.DomainList = append(fqdn.DomainList, fqdnFilter)
 			}
 		}
-		if err := client.UpdateDomains(fqdn); err != nil {
+		if err := client.UpdateDomainsInChunks(fqdn, goaviatrix.FQDNDomainListChunkSize); err != nil {
+			return fmt.Errorf("failed to set domain names: %s", err)
+		}
+	}
+
+	if domainNamesFile != "" || domainNamesURL != "" {
+		if !enabledInlineDomainNames {
+			return fmt.Errorf("manage_domain_names must be set to true to set domain names from a file or URL")
+		}
+		domainList, err := getFQDNDomainNamesFromSource(context.Background(), domainNamesFile, domainNamesURL, d.Get("domain_names_url_sha256").(string))
+		if err != nil {
+			return err
+		}
+		fqdn.DomainList = domainList
+		if err := client.UpdateDomainsInChunks(fqdn, goaviatrix.FQDNDomainListChunkSize); err != nil {
 			return fmt.Errorf("failed to set domain names: %s", err)
 		}
 	}
@@ -295,7 +339,9 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[INFO] Enable FQDN tag status: %#v", fqdn)
 
 		// Only write domain names to state if the user has enabled in-line domain names.
-		if d.Get("manage_domain_names").(bool) {
+		if d.Get("domain_names_file").(string) != "" || d.Get("domain_names_url").(string) != "" {
+			d.Set("domain_names_sha256", goaviatrix.FQDNDomainListHash(newfqdn.DomainList))
+		} else if d.Get("manage_domain_names").(bool) {
 			if err = d.Set("domain_names", filter); err != nil {
 				log.Printf("[WARN] Error setting domain_names for (%s): %s", d.Id(), err)
 			}
@@ -374,6 +420,8 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
+	domainNamesFile := d.Get("domain_names_file").(string)
+	domainNamesURL := d.Get("domain_names_url").(string)
 	if hasSetDomainNames && !enabledInlineDomainNames {
 		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
 	}
@@ -407,7 +455,21 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 	// Update Domain list
-	if d.HasChange("domain_names") && enabledInlineDomainNames {
+	if domainNamesFile != "" || domainNamesURL != "" {
+		if !enabledInlineDomainNames {
+			return fmt.Errorf("manage_domain_names must be set to true to set domain names from a file or URL")
+		}
+		if d.HasChanges("domain_names_file", "domain_names_url", "domain_names_sha256") {
+			domainList, err := getFQDNDomainNamesFromSource(context.Background(), domainNamesFile, domainNamesURL, d.Get("domain_names_url_sha256").(string))
+			if err != nil {
+				return err
+			}
+			fqdn.DomainList = domainList
+			if err := client.UpdateDomainsInChunks(fqdn, goaviatrix.FQDNDomainListChunkSize); err != nil {
+				return fmt.Errorf("failed to set domain names in update : %s", err)
+			}
+		}
+	} else if d.HasChange("domain_names") && enabledInlineDomainNames {
 		if hasSetDomainNames {
 			names := d.Get("domain_names").([]interface{})
 			mapDomains := make(map[string]bool)
@@ -427,7 +489,7 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 				fqdn.DomainList = append(fqdn.DomainList, fqdnDomain)
 			}
 		}
-		if err := client.UpdateDomains(fqdn); err != nil {
+		if err := client.UpdateDomainsInChunks(fqdn, goaviatrix.FQDNDomainListChunkSize); err != nil {
 			return fmt.Errorf("failed to set domain names in update : %s", err)
 		}
 	}
@@ -498,6 +560,39 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 	return resourceAviatrixFQDNRead(d, meta)
 }
 
+func getFQDNDomainNamesFromSource(ctx context.Context, path, url, checksum string) ([]*goaviatrix.Filters, error) {
+	if path != "" {
+		domainList, err := goaviatrix.ReadFQDNDomainListFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read domain_names_file %q: %v", path, err)
+		}
+		return domainList, nil
+	}
+
+	domainList, err := goaviatrix.FetchFQDNDomainList(ctx, url, checksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch domain_names_url %q: %v", url, err)
+	}
+	return domainList, nil
+}
+
+func resourceAviatrixFQDNCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	path := d.Get("domain_names_file").(string)
+	url := d.Get("domain_names_url").(string)
+	if path == "" && url == "" {
+		return nil
+	}
+
+	domainList, err := getFQDNDomainNamesFromSource(ctx, path, url, d.Get("domain_names_url_sha256").(string))
+	if err != nil {
+		return err
+	}
+	if hash := goaviatrix.FQDNDomainListHash(domainList); hash != d.Get("domain_names_sha256").(string) {
+		return d.SetNew("domain_names_sha256", hash)
+	}
+	return nil
+}
+
 func resourceAviatrixFQDNDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*goaviatrix.Client)
 
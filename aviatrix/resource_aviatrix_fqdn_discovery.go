@@ -0,0 +1,77 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixFQDNDiscovery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixFQDNDiscoveryCreate,
+		ReadWithoutTimeout:   resourceAviatrixFQDNDiscoveryRead,
+		DeleteWithoutTimeout: resourceAviatrixFQDNDiscoveryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the gateway to run FQDN discovery on.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixFQDNDiscoveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	err := client.StartFQDNDiscovery(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("could not start FQDN discovery on gateway %s: %v", gwName, err)
+	}
+
+	d.SetId(gwName)
+	return resourceAviatrixFQDNDiscoveryRead(ctx, d, meta)
+}
+
+func resourceAviatrixFQDNDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Id()
+	running, err := client.GetFQDNDiscoveryStatus(ctx, gwName)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not get FQDN discovery status of gateway %s: %v", gwName, err)
+	}
+	if !running {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("gw_name", gwName)
+	return nil
+}
+
+func resourceAviatrixFQDNDiscoveryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	gwName := d.Get("gw_name").(string)
+	err := client.StopFQDNDiscovery(ctx, gwName)
+	if err != nil {
+		return diag.Errorf("could not stop FQDN discovery on gateway %s: %v", gwName, err)
+	}
+
+	return nil
+}
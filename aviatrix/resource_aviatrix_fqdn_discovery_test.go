@@ -0,0 +1,134 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixFQDNDiscovery_basic(t *testing.T) {
+	if os.Getenv("SKIP_FQDN_DISCOVERY") == "yes" {
+		t.Skip("Skipping FQDN discovery test as SKIP_FQDN_DISCOVERY is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_fqdn_discovery.test"
+	msg := ". Set SKIP_FQDN_DISCOVERY to yes to skip FQDN discovery tests."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFQDNDiscoveryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFQDNDiscoveryBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFQDNDiscoveryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfg-aws-%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFQDNDiscoveryBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_acc_aws" {
+	account_name       = "tf-acc-aws-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+
+resource "aviatrix_vpc" "test_vpc" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_acc_aws.account_name
+	region       = "%[5]s"
+	name         = "aws-vpc-%[1]s"
+	cidr         = "10.0.10.0/24"
+}
+
+data "aviatrix_vpc" "test_vpc" {
+	name = aviatrix_vpc.test_vpc.name
+}
+
+resource "aviatrix_gateway" "test_gw_aws" {
+	cloud_type     = 1
+	account_name   = aviatrix_account.test_acc_aws.account_name
+	gw_name        = "tfg-aws-%[1]s"
+	vpc_id         = aviatrix_vpc.test_vpc.vpc_id
+	vpc_reg        = "%[5]s"
+	gw_size        = "t2.micro"
+	subnet         = data.aviatrix_vpc.test_vpc.public_subnets[0].cidr
+	single_ip_snat = true
+}
+
+resource "aviatrix_fqdn_discovery" "test" {
+	gw_name = aviatrix_gateway.test_gw_aws.gw_name
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_REGION"))
+}
+
+func testAccCheckFQDNDiscoveryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("fqdn_discovery Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no fqdn_discovery ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		running, err := client.GetFQDNDiscoveryStatus(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !running {
+			return fmt.Errorf("FQDN discovery is not running on gateway %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFQDNDiscoveryDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_fqdn_discovery" {
+			continue
+		}
+
+		running, err := client.GetFQDNDiscoveryStatus(context.Background(), rs.Primary.ID)
+		if err == goaviatrix.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if running {
+			return fmt.Errorf("FQDN discovery still running on gateway %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package aviatrix
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
@@ -95,6 +96,57 @@ resource "aviatrix_fqdn" "foo" {
 		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"))
 }
 
+func TestAccAviatrixFQDN_domainNamesFile(t *testing.T) {
+	var fqdn goaviatrix.FQDN
+
+	rName := acctest.RandString(5)
+
+	skipAcc := os.Getenv("SKIP_FQDN")
+	if skipAcc == "yes" {
+		t.Skip("Skipping FQDN test as SKIP_FQDN is set")
+	}
+
+	resourceName := "aviatrix_fqdn.foo"
+	domainNamesFile := filepath.Join(t.TempDir(), "domains.txt")
+	err := os.WriteFile(domainNamesFile, []byte("# test list\nfacebook.com,tcp,443\nGitHub.com,tcp,443,allow\nfacebook.com,tcp,443\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFQDNDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFQDNConfigDomainNamesFile(rName, domainNamesFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFQDNExists(resourceName, &fqdn),
+					resource.TestCheckResourceAttr(resourceName, "fqdn_tag", fmt.Sprintf("tff-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "domain_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "domain_names_sha256", goaviatrix.FQDNDomainListHash([]*goaviatrix.Filters{
+						{FQDN: "facebook.com", Protocol: "tcp", Port: "443", Verdict: "Base Policy"},
+						{FQDN: "github.com", Protocol: "tcp", Port: "443", Verdict: "Allow"},
+					})),
+				),
+			},
+		},
+	})
+}
+
+func testAccFQDNConfigDomainNamesFile(rName, domainNamesFile string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_fqdn" "foo" {
+	fqdn_tag          = "tff-%s"
+	fqdn_enabled      = true
+	fqdn_mode         = "white"
+	domain_names_file = "%s"
+}
+	`, rName, domainNamesFile)
+}
+
 func testAccCheckFQDNExists(n string, fqdn *goaviatrix.FQDN) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
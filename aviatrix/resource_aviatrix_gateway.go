@@ -23,6 +23,7 @@ func resourceAviatrixGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAccountAudit,
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
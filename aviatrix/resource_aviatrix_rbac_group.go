@@ -1,8 +1,10 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +19,7 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAviatrixRbacGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -31,10 +34,166 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 				Default:     false,
 				Description: "Whether to allow members of an RBAC group to bypass LDAP/MFA for Duo login",
 			},
+			"manage_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Switch to manage the permissions of the RBAC group exclusively with the 'permissions' attribute. " +
+					"If false, permissions must be managed using the aviatrix_rbac_group_permission_attachment resource. " +
+					"Valid values: true, false. Default value: false.",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the permissions of the RBAC group. Only valid when 'manage_permissions' is true.",
+			},
+			"manage_users": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Switch to manage the users of the RBAC group exclusively with the 'users' attribute. " +
+					"If false, users must be managed using the aviatrix_rbac_group_user_attachment resource. " +
+					"Valid values: true, false. Default value: false.",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the account users of the RBAC group. Only valid when 'manage_users' is true.",
+			},
+			"manage_access_accounts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Switch to manage the access accounts of the RBAC group exclusively with the 'access_accounts' attribute. " +
+					"If false, access accounts must be managed using the aviatrix_rbac_group_access_account_attachment resource. " +
+					"Valid values: true, false. Default value: false.",
+			},
+			"access_accounts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the access accounts of the RBAC group. Only valid when 'manage_access_accounts' is true.",
+			},
 		},
 	}
 }
 
+func resourceAviatrixRbacGroupCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, attr := range []string{"permissions", "users", "access_accounts"} {
+		if !diff.Get("manage_"+attr).(bool) && diff.Get(attr).(*schema.Set).Len() != 0 {
+			return fmt.Errorf("'%s' requires 'manage_%s' to be true", attr, attr)
+		}
+	}
+
+	if diff.HasChange("permissions") && diff.NewValueKnown("permissions") {
+		var permissions []string
+		for _, v := range diff.Get("permissions").(*schema.Set).List() {
+			permissions = append(permissions, v.(string))
+		}
+		if len(permissions) != 0 {
+			return validateRbacPermissionNames(meta.(*goaviatrix.Client), permissions)
+		}
+	}
+	return nil
+}
+
+func validateRbacPermissionNames(client *goaviatrix.Client, permissions []string) error {
+	catalogue, err := client.ListRbacPermissions()
+	if err != nil {
+		log.Printf("[WARN] Could not list RBAC permissions, skipping permission name validation: %v", err)
+		return nil
+	}
+	return goaviatrix.ValidateRbacPermissionNames(permissions, catalogue)
+}
+
+// updateRbacGroupMembers makes the permissions, users and access accounts of the RBAC group match the
+// configuration for every attribute that is managed by the aviatrix_rbac_group resource.
+func updateRbacGroupMembers(d *schema.ResourceData, client *goaviatrix.Client) error {
+	groupName := d.Get("group_name").(string)
+
+	if d.Get("manage_permissions").(bool) {
+		current, err := client.ListRbacGroupPermissions(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list permissions of Aviatrix RBAC permission group: %s", err)
+		}
+		desired := getStringSet(d, "permissions")
+		if toDelete := goaviatrix.Difference(current, desired); len(toDelete) != 0 {
+			err := client.DeleteRbacGroupPermissionAttachment(&goaviatrix.RbacGroupPermissionAttachment{
+				GroupName:      groupName,
+				PermissionName: strings.Join(toDelete, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete permissions from Aviatrix RBAC permission group: %s", err)
+			}
+		}
+		if toAdd := goaviatrix.Difference(desired, current); len(toAdd) != 0 {
+			err := client.CreateRbacGroupPermissionAttachment(&goaviatrix.RbacGroupPermissionAttachment{
+				GroupName:      groupName,
+				PermissionName: strings.Join(toAdd, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add permissions to Aviatrix RBAC permission group: %s", err)
+			}
+		}
+	}
+
+	if d.Get("manage_users").(bool) {
+		current, err := client.ListRbacGroupUsers(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list users of Aviatrix RBAC permission group: %s", err)
+		}
+		desired := getStringSet(d, "users")
+		if toDelete := goaviatrix.Difference(current, desired); len(toDelete) != 0 {
+			err := client.DeleteRbacGroupUserAttachment(&goaviatrix.RbacGroupUserAttachment{
+				GroupName: groupName,
+				UserName:  strings.Join(toDelete, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete users from Aviatrix RBAC permission group: %s", err)
+			}
+		}
+		if toAdd := goaviatrix.Difference(desired, current); len(toAdd) != 0 {
+			err := client.CreateRbacGroupUserAttachment(&goaviatrix.RbacGroupUserAttachment{
+				GroupName: groupName,
+				UserName:  strings.Join(toAdd, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add users to Aviatrix RBAC permission group: %s", err)
+			}
+		}
+	}
+
+	if d.Get("manage_access_accounts").(bool) {
+		current, err := client.ListRbacGroupAccessAccounts(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list access accounts of Aviatrix RBAC permission group: %s", err)
+		}
+		desired := getStringSet(d, "access_accounts")
+		if toDelete := goaviatrix.Difference(current, desired); len(toDelete) != 0 {
+			err := client.DeleteRbacGroupAccessAccountAttachment(&goaviatrix.RbacGroupAccessAccountAttachment{
+				GroupName:         groupName,
+				AccessAccountName: strings.Join(toDelete, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete access accounts from Aviatrix RBAC permission group: %s", err)
+			}
+		}
+		if toAdd := goaviatrix.Difference(desired, current); len(toAdd) != 0 {
+			err := client.CreateRbacGroupAccessAccountAttachment(&goaviatrix.RbacGroupAccessAccountAttachment{
+				GroupName:         groupName,
+				AccessAccountName: strings.Join(toAdd, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add access accounts to Aviatrix RBAC permission group: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*goaviatrix.Client)
 
@@ -63,6 +222,10 @@ func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if err := updateRbacGroupMembers(d, client); err != nil {
+		return err
+	}
+
 	return resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
 }
 
@@ -70,17 +233,26 @@ func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*goaviatrix.Client)
 	groupName := d.Get("group_name").(string)
 
-	if d.Get("local_login").(bool) {
-		err := client.EnableLocalLoginForRBACGroup(groupName)
-		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+	if d.HasChange("local_login") {
+		if d.Get("local_login").(bool) {
+			err := client.EnableLocalLoginForRBACGroup(groupName)
+			if err != nil {
+				return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			}
+		} else {
+			err := client.DisableLocalLoginForRBACGroup(groupName)
+			if err != nil {
+				return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+			}
 		}
-	} else {
-		err := client.DisableLocalLoginForRBACGroup(groupName)
-		if err != nil {
-			return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+	}
+
+	if d.HasChanges("manage_permissions", "permissions", "manage_users", "users", "manage_access_accounts", "access_accounts") {
+		if err := updateRbacGroupMembers(d, client); err != nil {
+			return err
 		}
 	}
+
 	return resourceAviatrixRbacGroupRead(d, meta)
 }
 
@@ -124,6 +296,34 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 		d.SetId(rGroup.GroupName)
 	}
 
+	if d.Get("manage_permissions").(bool) {
+		permissions, err := client.ListRbacGroupPermissions(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list permissions of Aviatrix RBAC permission group: %s", err)
+		}
+		if err := d.Set("permissions", permissions); err != nil {
+			return fmt.Errorf("couldn't set permissions: %s", err)
+		}
+	}
+	if d.Get("manage_users").(bool) {
+		users, err := client.ListRbacGroupUsers(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list users of Aviatrix RBAC permission group: %s", err)
+		}
+		if err := d.Set("users", users); err != nil {
+			return fmt.Errorf("couldn't set users: %s", err)
+		}
+	}
+	if d.Get("manage_access_accounts").(bool) {
+		accessAccounts, err := client.ListRbacGroupAccessAccounts(groupName)
+		if err != nil {
+			return fmt.Errorf("couldn't list access accounts of Aviatrix RBAC permission group: %s", err)
+		}
+		if err := d.Set("access_accounts", accessAccounts); err != nil {
+			return fmt.Errorf("couldn't set access accounts: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -46,6 +46,62 @@ func TestAccAviatrixRbacGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAviatrixRbacGroup_managePermissions(t *testing.T) {
+	var rbacGroup goaviatrix.RbacGroup
+
+	rName := acctest.RandString(5)
+
+	skipAcc := os.Getenv("SKIP_RBAC_GROUP")
+	if skipAcc == "yes" {
+		t.Skip("Skipping rbac group tests as SKIP_RBAC_GROUP is set")
+	}
+
+	resourceName := "aviatrix_rbac_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRbacGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRbacGroupConfigManagePermissions(rName, `"all_gateway_write", "all_peering_write"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupExists(resourceName, &rbacGroup),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "all_gateway_write"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "all_peering_write"),
+				),
+			},
+			{
+				Config: testAccRbacGroupConfigManagePermissions(rName, `"all_gateway_write"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRbacGroupExists(resourceName, &rbacGroup),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", "all_gateway_write"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_permissions", "permissions"},
+			},
+		},
+	})
+}
+
+func testAccRbacGroupConfigManagePermissions(rName, permissions string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_rbac_group" "test" {
+	group_name         = "tf-%s"
+	manage_permissions = true
+	permissions        = [%s]
+}
+	`, rName, permissions)
+}
+
 func testAccRbacGroupConfigBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aviatrix_rbac_group" "test" {
@@ -1,9 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,6 +22,7 @@ func resourceAviatrixSamlEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAviatrixSamlEndpointCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"endpoint_name": {
@@ -90,6 +93,56 @@ func resourceAviatrixSamlEndpoint() *schema.Resource {
 				Default:     false,
 				Description: "Whether to sign SAML AuthnRequests",
 			},
+			"auto_refresh_idp_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether to re-upload the IDP metadata when the metadata served at 'idp_metadata_url' changes, " +
+					"e.g. after an IDP certificate rollover. Only valid for 'idp_metadata_type' 'URL'.",
+			},
+			"idp_group_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the SAML attribute carrying the IDP groups of the user. Required for 'idp_group_mapping'.",
+			},
+			"idp_group_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Mapping of IDP groups to RBAC groups for controller login or to VPN profiles for VPN login.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idp_group": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "IDP group name.",
+						},
+						"rbac_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "RBAC groups assigned to members of the IDP group. Only valid for controller login.",
+						},
+						"vpn_profiles": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "VPN profiles assigned to members of the IDP group. Only valid for VPN login.",
+						},
+					},
+				},
+			},
+			"idp_metadata_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the signing certificates and SSO endpoints in the IDP metadata.",
+			},
+			"idp_certificate_expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Earliest expiry time of the IDP certificates in the IDP metadata, in RFC 3339 format.",
+			},
 		},
 	}
 }
@@ -111,6 +164,8 @@ func resourceAviatrixSamlEndpointCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("failed to create Aviatrix SAML endpoint: %s", err)
 	}
 
+	setSamlEndpointIdpMetadataInfo(context.Background(), d, samlEndpoint.IdpMetadata)
+
 	return resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
 }
 
@@ -127,7 +182,8 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 
 	endpointName := d.Get("endpoint_name").(string)
 
-	if endpointName == "" {
+	isImport := endpointName == ""
+	if isImport {
 		id := d.Id()
 		log.Printf("[DEBUG] Looks like an import, no SAML endpoint names received. Import Id is %s", id)
 		d.Set("endpoint_name", id)
@@ -153,8 +209,14 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 	d.Set("idp_metadata_type", saml.IdpMetadataType)
 	if saml.IdpMetadataType == "URL" {
 		d.Set("idp_metadata_url", saml.IdpMetadataURL)
+		// with auto_refresh_idp_metadata, the checksum of the metadata last uploaded is kept so that
+		// CustomizeDiff can detect changes at the IDP, otherwise the metadata served at the URL is read
+		if isImport || !d.Get("auto_refresh_idp_metadata").(bool) {
+			setSamlEndpointIdpMetadataInfo(context.Background(), d, saml.IdpMetadataURL)
+		}
 	} else {
 		d.Set("idp_metadata", saml.IdpMetadata)
+		setSamlEndpointIdpMetadataInfo(context.Background(), d, saml.IdpMetadata)
 	}
 	d.Set("custom_entity_id", saml.CustomEntityId)
 	d.Set("sign_authn_requests", saml.SignAuthnRequests)
@@ -186,6 +248,19 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 		d.Set("rbac_groups", []string{})
 	}
 
+	d.Set("idp_group_attribute", saml.GroupAttribute)
+	var groupMapping []map[string]interface{}
+	for _, mapping := range saml.GroupMapping {
+		groupMapping = append(groupMapping, map[string]interface{}{
+			"idp_group":    mapping.IdpGroup,
+			"rbac_groups":  mapping.RbacGroups,
+			"vpn_profiles": mapping.VpnProfiles,
+		})
+	}
+	if err := d.Set("idp_group_mapping", groupMapping); err != nil {
+		log.Printf("[WARN] Error setting 'idp_group_mapping' for (%s): %s", d.Id(), err)
+	}
+
 	d.SetId(saml.EndPointName)
 	return nil
 }
@@ -202,6 +277,8 @@ func resourceAviatrixSamlEndpointUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("failed to edit Aviatrix SAML endpoint: %s", err)
 	}
 
+	setSamlEndpointIdpMetadataInfo(context.Background(), d, samlEndpoint.IdpMetadata)
+
 	d.SetId(samlEndpoint.EndPointName)
 	return resourceAviatrixSamlEndpointRead(d, meta)
 }
@@ -269,5 +346,103 @@ func GetAviatrixSamlEndpointInput(d *schema.ResourceData) (*goaviatrix.SamlEndpo
 	} else if samlEndpoint.ControllerLogin == "yes" && samlEndpoint.AccessSetBy != "controller" && samlEndpoint.RbacGroups != "" {
 		return nil, fmt.Errorf("'rbac_groups' is only supported for 'access_set_by' of 'controller'")
 	}
+
+	samlEndpoint.GroupAttribute = d.Get("idp_group_attribute").(string)
+	for _, v := range d.Get("idp_group_mapping").([]interface{}) {
+		mapping := v.(map[string]interface{})
+		groupMapping := goaviatrix.SamlGroupMapping{
+			IdpGroup: mapping["idp_group"].(string),
+		}
+		for _, rbacGroup := range mapping["rbac_groups"].(*schema.Set).List() {
+			groupMapping.RbacGroups = append(groupMapping.RbacGroups, rbacGroup.(string))
+		}
+		for _, vpnProfile := range mapping["vpn_profiles"].(*schema.Set).List() {
+			groupMapping.VpnProfiles = append(groupMapping.VpnProfiles, vpnProfile.(string))
+		}
+		if len(groupMapping.RbacGroups) == 0 && len(groupMapping.VpnProfiles) == 0 {
+			return nil, fmt.Errorf("'idp_group_mapping' for IDP group %q must map to at least one RBAC group or VPN profile", groupMapping.IdpGroup)
+		}
+		if samlEndpoint.ControllerLogin == "yes" && len(groupMapping.VpnProfiles) != 0 {
+			return nil, fmt.Errorf("'vpn_profiles' in 'idp_group_mapping' is only supported for VPN login")
+		}
+		if samlEndpoint.ControllerLogin != "yes" && len(groupMapping.RbacGroups) != 0 {
+			return nil, fmt.Errorf("'rbac_groups' in 'idp_group_mapping' is only supported for controller login")
+		}
+		if samlEndpoint.ControllerLogin == "yes" && samlEndpoint.AccessSetBy != "profile_attribute" {
+			return nil, fmt.Errorf("'idp_group_mapping' is only supported for 'access_set_by' of 'profile_attribute' for controller login")
+		}
+		samlEndpoint.GroupMappingList = append(samlEndpoint.GroupMappingList, groupMapping)
+	}
+	if len(samlEndpoint.GroupMappingList) != 0 && samlEndpoint.GroupAttribute == "" {
+		return nil, fmt.Errorf("'idp_group_attribute' is required when 'idp_group_mapping' is set")
+	}
+
 	return samlEndpoint, nil
 }
+
+// setSamlEndpointIdpMetadataInfo sets the checksum and certificate expiry of the IDP metadata. For
+// 'idp_metadata_type' 'URL', idpMetadata is the URL the metadata is fetched from.
+func setSamlEndpointIdpMetadataInfo(ctx context.Context, d *schema.ResourceData, idpMetadata string) {
+	if d.Get("idp_metadata_type").(string) == "URL" {
+		metadata, err := goaviatrix.FetchSamlIdpMetadata(ctx, idpMetadata)
+		if err != nil {
+			log.Printf("[WARN] Could not fetch IDP metadata for SAML endpoint %s: %v", d.Id(), err)
+			return
+		}
+		idpMetadata = metadata
+	}
+
+	info, err := goaviatrix.ParseSamlIdpMetadata(idpMetadata)
+	if err != nil {
+		log.Printf("[WARN] Could not parse IDP metadata for SAML endpoint %s: %v", d.Id(), err)
+		d.Set("idp_metadata_sha256", "")
+		d.Set("idp_certificate_expiry", "")
+		return
+	}
+
+	d.Set("idp_metadata_sha256", info.Sha256)
+	if info.CertificateExpiry.IsZero() {
+		d.Set("idp_certificate_expiry", "")
+	} else {
+		d.Set("idp_certificate_expiry", info.CertificateExpiry.UTC().Format(time.RFC3339))
+	}
+}
+
+func resourceAviatrixSamlEndpointCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("auto_refresh_idp_metadata").(bool) && diff.NewValueKnown("idp_metadata_type") && diff.Get("idp_metadata_type").(string) != "URL" {
+		return fmt.Errorf("'auto_refresh_idp_metadata' is only supported for 'idp_metadata_type' 'URL'")
+	}
+
+	if diff.Id() == "" || !diff.Get("auto_refresh_idp_metadata").(bool) || diff.Get("idp_metadata_type").(string) != "URL" {
+		return nil
+	}
+
+	if diff.HasChanges("idp_metadata_type", "idp_metadata_url") {
+		if err := diff.SetNewComputed("idp_metadata_sha256"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("idp_certificate_expiry")
+	}
+
+	metadata, err := goaviatrix.FetchSamlIdpMetadata(ctx, diff.Get("idp_metadata_url").(string))
+	if err != nil {
+		log.Printf("[WARN] Could not fetch IDP metadata to check for changes for SAML endpoint %s: %v", diff.Id(), err)
+		return nil
+	}
+	info, err := goaviatrix.ParseSamlIdpMetadata(metadata)
+	if err != nil {
+		log.Printf("[WARN] Could not parse IDP metadata to check for changes for SAML endpoint %s: %v", diff.Id(), err)
+		return nil
+	}
+
+	// the IDP metadata changed since it was last uploaded, force an update so it is re-uploaded
+	if info.Sha256 != diff.Get("idp_metadata_sha256").(string) {
+		log.Printf("[INFO] IDP metadata for SAML endpoint %s changed, it will be re-uploaded", diff.Id())
+		if err := diff.SetNew("idp_metadata_sha256", info.Sha256); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("idp_certificate_expiry")
+	}
+
+	return nil
+}
@@ -52,6 +52,7 @@ func TestAccAviatrixSamlEndpoint_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "endpoint_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "idp_metadata", idpMetadata),
 					resource.TestCheckResourceAttr(resourceName, "idp_metadata_type", idpMetadataType),
+					resource.TestCheckResourceAttrSet(resourceName, "idp_metadata_sha256"),
 				),
 			},
 			{
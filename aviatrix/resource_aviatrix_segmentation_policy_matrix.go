@@ -0,0 +1,335 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixSegmentationPolicyMatrix() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixSegmentationPolicyMatrixCreate,
+		ReadWithoutTimeout:   resourceAviatrixSegmentationPolicyMatrixRead,
+		UpdateWithoutTimeout: resourceAviatrixSegmentationPolicyMatrixUpdate,
+		DeleteWithoutTimeout: resourceAviatrixSegmentationPolicyMatrixDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domains": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Set of network domains whose connection policies are managed by this resource.",
+			},
+			"connection_policy": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of allowed connections between the managed network domains.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name_1": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of network domain that will be connected to domain 2.",
+						},
+						"domain_name_2": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of network domain that will be connected to domain 1.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// segmentationPolicyKey returns an order independent key for a connection between two domains
+func segmentationPolicyKey(domainName1, domainName2 string) string {
+	pair := []string{domainName1, domainName2}
+	sort.Strings(pair)
+	return pair[0] + "~" + pair[1]
+}
+
+func expandSegmentationPolicyMatrixConnections(connections []interface{}) map[string][]string {
+	policies := make(map[string][]string)
+	for _, v := range connections {
+		connection := v.(map[string]interface{})
+		domainName1 := connection["domain_name_1"].(string)
+		domainName2 := connection["domain_name_2"].(string)
+		policies[segmentationPolicyKey(domainName1, domainName2)] = []string{domainName1, domainName2}
+	}
+	return policies
+}
+
+func validateSegmentationPolicyMatrix(ctx context.Context, client *goaviatrix.Client, domains []string, policies map[string][]string) error {
+	for _, pair := range policies {
+		if pair[0] == pair[1] {
+			return fmt.Errorf("network domain %q can not be connected to itself", pair[0])
+		}
+		for _, domainName := range pair {
+			if !goaviatrix.Contains(domains, domainName) {
+				return fmt.Errorf("network domain %q in 'connection_policy' is not listed in 'domains'", domainName)
+			}
+		}
+	}
+
+	existingDomains, err := client.GetSegmentationSecurityDomainNames(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list network domains: %v", err)
+	}
+	for _, domainName := range domains {
+		if !goaviatrix.Contains(existingDomains, domainName) {
+			return fmt.Errorf("network domain %q does not exist", domainName)
+		}
+	}
+	return nil
+}
+
+// segmentationPolicyMatrixID returns an ID derived from the set of managed domains, so that resources
+// managing different domains have different IDs
+func segmentationPolicyMatrixID(domains []string) string {
+	sortedDomains := append([]string{}, domains...)
+	sort.Strings(sortedDomains)
+	return strings.Join(sortedDomains, "~")
+}
+
+// getSegmentationPolicyMatrixConnections returns all connections between the given domains keyed by segmentationPolicyKey
+func getSegmentationPolicyMatrixConnections(ctx context.Context, client *goaviatrix.Client, domains []string) (map[string][]string, error) {
+	policies := make(map[string][]string)
+	for _, domainName := range domains {
+		connectedDomains, err := client.GetSegmentationSecurityDomainConnectedDomains(ctx, domainName)
+		if err != nil {
+			return nil, fmt.Errorf("could not get connection policy of network domain %q: %v", domainName, err)
+		}
+		for _, connectedDomain := range connectedDomains {
+			if !goaviatrix.Contains(domains, connectedDomain) {
+				continue
+			}
+			key := segmentationPolicyKey(domainName, connectedDomain)
+			if _, ok := policies[key]; !ok {
+				policies[key] = strings.Split(key, "~")
+			}
+		}
+	}
+	return policies, nil
+}
+
+// getRemovedSegmentationPolicyMatrixConnections returns the connections in oldPolicies to domains that are no longer
+// listed in domains and still exist, i.e. connections made by the matrix that it would no longer manage
+func getRemovedSegmentationPolicyMatrixConnections(ctx context.Context, client *goaviatrix.Client, domains []string, oldPolicies map[string][]string) (map[string][]string, error) {
+	removed := make(map[string][]string)
+	var existingDomains []string
+	for key, pair := range oldPolicies {
+		if goaviatrix.Contains(domains, pair[0]) && goaviatrix.Contains(domains, pair[1]) {
+			continue
+		}
+		if existingDomains == nil {
+			var err error
+			existingDomains, err = client.GetSegmentationSecurityDomainNames(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not list network domains: %v", err)
+			}
+		}
+		if !goaviatrix.Contains(existingDomains, pair[0]) || !goaviatrix.Contains(existingDomains, pair[1]) {
+			continue
+		}
+		connectedDomains, err := client.GetSegmentationSecurityDomainConnectedDomains(ctx, pair[0])
+		if err != nil {
+			return nil, fmt.Errorf("could not get connection policy of network domain %q: %v", pair[0], err)
+		}
+		if goaviatrix.Contains(connectedDomains, pair[1]) {
+			removed[key] = pair
+		}
+	}
+	return removed, nil
+}
+
+func applySegmentationPolicyMatrix(ctx context.Context, client *goaviatrix.Client, current, desired map[string][]string) error {
+	for key, pair := range current {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		log.Printf("[INFO] Disconnecting network domains %q and %q", pair[0], pair[1])
+		policy := &goaviatrix.SegmentationSecurityDomainConnectionPolicy{
+			Domain1: &goaviatrix.SegmentationSecurityDomain{DomainName: pair[0]},
+			Domain2: &goaviatrix.SegmentationSecurityDomain{DomainName: pair[1]},
+		}
+		if err := client.DeleteSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
+			return fmt.Errorf("could not disconnect network domains %q and %q: %v", pair[0], pair[1], err)
+		}
+	}
+
+	for key, pair := range desired {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		log.Printf("[INFO] Connecting network domains %q and %q", pair[0], pair[1])
+		policy := &goaviatrix.SegmentationSecurityDomainConnectionPolicy{
+			Domain1: &goaviatrix.SegmentationSecurityDomain{DomainName: pair[0]},
+			Domain2: &goaviatrix.SegmentationSecurityDomain{DomainName: pair[1]},
+		}
+		if err := client.CreateSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
+			return fmt.Errorf("could not connect network domains %q and %q: %v", pair[0], pair[1], err)
+		}
+	}
+	return nil
+}
+
+func resourceAviatrixSegmentationPolicyMatrixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	domains := getStringSet(d, "domains")
+	desired := expandSegmentationPolicyMatrixConnections(d.Get("connection_policy").(*schema.Set).List())
+	if err := validateSegmentationPolicyMatrix(ctx, client, domains, desired); err != nil {
+		return diag.Errorf("invalid inputs for segmentation policy matrix: %v", err)
+	}
+
+	current, err := getSegmentationPolicyMatrixConnections(ctx, client, domains)
+	if err != nil {
+		return diag.Errorf("failed to read existing segmentation connection policies: %v", err)
+	}
+
+	d.SetId(segmentationPolicyMatrixID(domains))
+	flag := false
+	defer resourceAviatrixSegmentationPolicyMatrixReadIfRequired(ctx, d, meta, &flag)
+
+	if err := applySegmentationPolicyMatrix(ctx, client, current, desired); err != nil {
+		return diag.Errorf("failed to create segmentation policy matrix: %v", err)
+	}
+
+	return resourceAviatrixSegmentationPolicyMatrixReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixSegmentationPolicyMatrixReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixSegmentationPolicyMatrixRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixSegmentationPolicyMatrixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	domains := getStringSet(d, "domains")
+	if len(domains) == 0 {
+		id := d.Id()
+		log.Printf("[DEBUG] Looks like an import, no domains received. Import Id is %s", id)
+		domains = strings.Split(id, "~")
+	}
+
+	// domains deleted outside of Terraform are no longer managed
+	existingDomains, err := client.GetSegmentationSecurityDomainNames(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list network domains: %v", err)
+	}
+	var managedDomains []string
+	for _, domainName := range domains {
+		if goaviatrix.Contains(existingDomains, domainName) {
+			managedDomains = append(managedDomains, domainName)
+		} else {
+			log.Printf("[WARN] Network domain %q of segmentation policy matrix %s no longer exists", domainName, d.Id())
+		}
+	}
+	if len(managedDomains) == 0 {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("domains", managedDomains); err != nil {
+		return diag.Errorf("failed to set domains: %v", err)
+	}
+
+	current, err := getSegmentationPolicyMatrixConnections(ctx, client, managedDomains)
+	if err != nil {
+		return diag.Errorf("failed to read segmentation policy matrix: %v", err)
+	}
+
+	// keep the domain order used in the configuration to avoid spurious diffs
+	configured := expandSegmentationPolicyMatrixConnections(d.Get("connection_policy").(*schema.Set).List())
+	var connections []map[string]interface{}
+	for key, pair := range current {
+		if configuredPair, ok := configured[key]; ok {
+			pair = configuredPair
+		}
+		connections = append(connections, map[string]interface{}{
+			"domain_name_1": pair[0],
+			"domain_name_2": pair[1],
+		})
+	}
+
+	if err := d.Set("connection_policy", connections); err != nil {
+		return diag.Errorf("failed to set connection_policy: %v", err)
+	}
+
+	d.SetId(segmentationPolicyMatrixID(managedDomains))
+	return nil
+}
+
+func resourceAviatrixSegmentationPolicyMatrixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	d.Partial(true)
+	if d.HasChanges("domains", "connection_policy") {
+		domains := getStringSet(d, "domains")
+		desired := expandSegmentationPolicyMatrixConnections(d.Get("connection_policy").(*schema.Set).List())
+		if err := validateSegmentationPolicyMatrix(ctx, client, domains, desired); err != nil {
+			return diag.Errorf("invalid inputs for segmentation policy matrix: %v", err)
+		}
+
+		// diff against the live connections so that connections made outside of Terraform are removed,
+		// connections of domains that are no longer managed are left untouched unless the matrix made them
+		current, err := getSegmentationPolicyMatrixConnections(ctx, client, domains)
+		if err != nil {
+			return diag.Errorf("failed to read existing segmentation connection policies: %v", err)
+		}
+		oldConnections, _ := d.GetChange("connection_policy")
+		removed, err := getRemovedSegmentationPolicyMatrixConnections(ctx, client, domains, expandSegmentationPolicyMatrixConnections(oldConnections.(*schema.Set).List()))
+		if err != nil {
+			return diag.Errorf("failed to read connection policies of removed network domains: %v", err)
+		}
+		for key, pair := range removed {
+			current[key] = pair
+		}
+		if err := applySegmentationPolicyMatrix(ctx, client, current, desired); err != nil {
+			return diag.Errorf("failed to update segmentation policy matrix: %v", err)
+		}
+	}
+
+	d.SetId(segmentationPolicyMatrixID(getStringSet(d, "domains")))
+	d.Partial(false)
+	return resourceAviatrixSegmentationPolicyMatrixRead(ctx, d, meta)
+}
+
+func resourceAviatrixSegmentationPolicyMatrixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	existingDomains, err := client.GetSegmentationSecurityDomainNames(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list network domains: %v", err)
+	}
+	var domains []string
+	for _, domainName := range getStringSet(d, "domains") {
+		if goaviatrix.Contains(existingDomains, domainName) {
+			domains = append(domains, domainName)
+		}
+	}
+
+	current, err := getSegmentationPolicyMatrixConnections(ctx, client, domains)
+	if err != nil {
+		return diag.Errorf("failed to read segmentation connection policies: %v", err)
+	}
+	if err := applySegmentationPolicyMatrix(ctx, client, current, map[string][]string{}); err != nil {
+		return diag.Errorf("failed to delete segmentation policy matrix: %v", err)
+	}
+
+	return nil
+}
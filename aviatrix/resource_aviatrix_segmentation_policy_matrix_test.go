@@ -0,0 +1,134 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixSegmentationPolicyMatrix_basic(t *testing.T) {
+	if os.Getenv("SKIP_SEGMENTATION_POLICY_MATRIX") == "yes" {
+		t.Skip("Skipping segmentation policy matrix test as SKIP_SEGMENTATION_POLICY_MATRIX is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_segmentation_policy_matrix.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentationPolicyMatrixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentationPolicyMatrixBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentationPolicyMatrixExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "connection_policy.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSegmentationPolicyMatrixBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_segmentation_network_domain" "test_1" {
+	domain_name = "segmentation-sd-1-%[1]s"
+}
+
+resource "aviatrix_segmentation_network_domain" "test_2" {
+	domain_name = "segmentation-sd-2-%[1]s"
+}
+
+resource "aviatrix_segmentation_network_domain" "test_3" {
+	domain_name = "segmentation-sd-3-%[1]s"
+}
+
+resource "aviatrix_segmentation_policy_matrix" "test" {
+	domains = [
+		aviatrix_segmentation_network_domain.test_1.domain_name,
+		aviatrix_segmentation_network_domain.test_2.domain_name,
+		aviatrix_segmentation_network_domain.test_3.domain_name,
+	]
+
+	connection_policy {
+		domain_name_1 = aviatrix_segmentation_network_domain.test_1.domain_name
+		domain_name_2 = aviatrix_segmentation_network_domain.test_2.domain_name
+	}
+
+	connection_policy {
+		domain_name_1 = aviatrix_segmentation_network_domain.test_1.domain_name
+		domain_name_2 = aviatrix_segmentation_network_domain.test_3.domain_name
+	}
+}
+`, rName)
+}
+
+func testAccCheckSegmentationPolicyMatrixExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("segmentation_policy_matrix Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no segmentation_policy_matrix ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		domainName1 := rs.Primary.Attributes["connection_policy.0.domain_name_1"]
+		domainName2 := rs.Primary.Attributes["connection_policy.0.domain_name_2"]
+		connectedDomains, err := client.GetSegmentationSecurityDomainConnectedDomains(context.Background(), domainName1)
+		if err != nil {
+			return err
+		}
+		if !goaviatrix.Contains(connectedDomains, domainName2) {
+			return fmt.Errorf("segmentation_policy_matrix connection between %s and %s not found", domainName1, domainName2)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSegmentationPolicyMatrixDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_segmentation_policy_matrix" {
+			continue
+		}
+
+		domainName1 := rs.Primary.Attributes["connection_policy.0.domain_name_1"]
+		domainName2 := rs.Primary.Attributes["connection_policy.0.domain_name_2"]
+		connectedDomains, err := client.GetSegmentationSecurityDomainConnectedDomains(context.Background(), domainName1)
+		if err == nil && goaviatrix.Contains(connectedDomains, domainName2) {
+			return fmt.Errorf("segmentation_policy_matrix still exists")
+		}
+	}
+
+	return nil
+}
+
+func TestSegmentationPolicyMatrixID(t *testing.T) {
+	id := segmentationPolicyMatrixID([]string{"domain-c", "domain-a", "domain-b"})
+	if id != "domain-a~domain-b~domain-c" {
+		t.Errorf("expected ID domain-a~domain-b~domain-c, got %s", id)
+	}
+	if segmentationPolicyMatrixID([]string{"domain-a", "domain-b"}) == id {
+		t.Errorf("expected different IDs for different domain sets")
+	}
+}
@@ -1,9 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -400,6 +402,19 @@ func resourceAviatrixSite2Cloud() *schema.Resource {
 				DiffSuppressFunc: goaviatrix.S2CPh1RemoteIdDiffSuppressFunc,
 				Description:      "Phase 1 remote identifier of the IPsec tunnel.",
 			},
+			"wait_for_up": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for all tunnels of the connection to be up after creation.",
+			},
+			"wait_for_up_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of minutes to wait for the tunnels to be up when 'wait_for_up' is enabled.",
+			},
 		},
 	}
 }
@@ -764,6 +779,14 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.Get("wait_for_up").(bool) {
+		timeout := time.Duration(d.Get("wait_for_up_timeout").(int)) * time.Minute
+		err := client.WaitForSite2CloudTunnelsUp(context.Background(), s2c.VpcID, s2c.TunnelName, timeout)
+		if err != nil {
+			return fmt.Errorf("failed to wait for Site2Cloud tunnels to be up: %v", err)
+		}
+	}
+
 	return resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
 }
 
@@ -789,6 +812,8 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
+		d.Set("wait_for_up", false)
+		d.Set("wait_for_up_timeout", 10)
 		d.SetId(id)
 	}
 
@@ -1200,6 +1225,15 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Partial(false)
+
+	if d.Get("wait_for_up").(bool) {
+		timeout := time.Duration(d.Get("wait_for_up_timeout").(int)) * time.Minute
+		err := client.WaitForSite2CloudTunnelsUp(context.Background(), editSite2cloud.VpcID, editSite2cloud.ConnName, timeout)
+		if err != nil {
+			return fmt.Errorf("failed to wait for Site2Cloud tunnels to be up: %v", err)
+		}
+	}
+
 	d.SetId(editSite2cloud.ConnName + "~" + editSite2cloud.VpcID)
 	return resourceAviatrixSite2CloudRead(d, meta)
 }
@@ -25,6 +25,7 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAccountAudit,
 
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -20,6 +20,7 @@ func resourceAviatrixSpokeHaGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffPrimaryGatewayAccountAudit,
 
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
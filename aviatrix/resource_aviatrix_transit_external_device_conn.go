@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -376,6 +377,19 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				ForceNew:    true,
 				Description: "Backup Local LAN IP. Required for GCP BGP over LAN Connection with HA enabled.",
 			},
+			"wait_for_up": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for all tunnels of the connection to be up after creation.",
+			},
+			"wait_for_up_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of minutes to wait for the tunnels to be up when 'wait_for_up' is enabled.",
+			},
 		},
 	}
 }
@@ -776,6 +790,14 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		}
 	}
 
+	if d.Get("wait_for_up").(bool) {
+		timeout := time.Duration(d.Get("wait_for_up_timeout").(int)) * time.Minute
+		err := client.WaitForSite2CloudTunnelsUp(context.Background(), externalDeviceConn.VpcID, externalDeviceConn.ConnectionName, timeout)
+		if err != nil {
+			return fmt.Errorf("failed to wait for external device connection tunnels to be up: %v", err)
+		}
+	}
+
 	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
 }
 
@@ -801,6 +823,8 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
+		d.Set("wait_for_up", false)
+		d.Set("wait_for_up_timeout", 10)
 		d.SetId(id)
 	}
 
@@ -1203,6 +1227,14 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 
 	d.Partial(false)
 
+	if d.Get("wait_for_up").(bool) {
+		timeout := time.Duration(d.Get("wait_for_up_timeout").(int)) * time.Minute
+		err := client.WaitForSite2CloudTunnelsUp(context.Background(), d.Get("vpc_id").(string), d.Get("connection_name").(string), timeout)
+		if err != nil {
+			return fmt.Errorf("failed to wait for external device connection tunnels to be up: %v", err)
+		}
+	}
+
 	return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
 }
 
@@ -1,22 +1,26 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProfileCreate,
-		Read:   resourceAviatrixProfileRead,
-		Update: resourceAviatrixProfileUpdate,
-		Delete: resourceAviatrixProfileDelete,
+		CreateWithoutTimeout: resourceAviatrixProfileCreate,
+		ReadWithoutTimeout:   resourceAviatrixProfileRead,
+		UpdateWithoutTimeout: resourceAviatrixProfileUpdate,
+		DeleteWithoutTimeout: resourceAviatrixProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceAviatrixProfileCustomizeDiff,
 
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -82,7 +86,37 @@ func resourceAviatrixProfile() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	for i, v := range d.Get("policy").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		rule := expandProfileRule(v.(map[string]interface{}))
+		// Rules with values that are only known after apply are validated during apply
+		if rule.Action == "" || rule.Protocol == "" || rule.Port == "" || rule.Target == "" {
+			continue
+		}
+		if err := goaviatrix.CheckProfileRule(rule); err != nil {
+			return fmt.Errorf("invalid policy: policy %d (%s): %v", i, rule, err)
+		}
+	}
+	return nil
+}
+
+func expandProfileRule(dn map[string]interface{}) goaviatrix.ProfileRule {
+	return goaviatrix.ProfileRule{
+		Action:   dn["action"].(string),
+		Protocol: dn["proto"].(string),
+		Port:     dn["port"].(string),
+		Target:   dn["target"].(string),
+	}
+}
+
+func resourceAviatrixProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	log.Printf("[INFO] Creating Aviatrix Profile: %v %T", d.Get("users"), d.Get("users"))
@@ -93,7 +127,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		Policy:   make([]goaviatrix.ProfileRule, 0),
 	}
 	if profile.Name == "" {
-		return fmt.Errorf("profile name can't be empty string")
+		return diag.Errorf("profile name can't be empty string")
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
@@ -103,7 +137,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
@@ -112,18 +146,12 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
 		if domain != nil {
-			dn := domain.(map[string]interface{})
-			profileRule := &goaviatrix.ProfileRule{
-				Action:   dn["action"].(string),
-				Protocol: dn["proto"].(string),
-				Port:     dn["port"].(string),
-				Target:   dn["target"].(string),
-			}
-			err := client.ValidateProfileRule(profileRule)
+			profileRule := expandProfileRule(domain.(map[string]interface{}))
+			err := goaviatrix.CheckProfileRule(profileRule)
 			if err != nil {
-				return fmt.Errorf("policy validation failed: %v", err)
+				return diag.Errorf("policy validation failed: %v", err)
 			}
-			profile.Policy = append(profile.Policy, *profileRule)
+			profile.Policy = append(profile.Policy, profileRule)
 		}
 	}
 
@@ -131,25 +159,29 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(profile.Name)
 	flag := false
-	defer resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 
 	err := client.CreateProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 	}
 
-	return resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	diags := resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, profilePolicyWarnings(ctx, client, profile)...)
 }
 
-func resourceAviatrixProfileReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixProfileReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixProfileRead(d, meta)
+		return resourceAviatrixProfileRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profileName := d.Get("name").(string)
@@ -168,7 +200,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 
 	profileBase, errBase := client.GetProfileBasePolicy(profile)
 	if errBase != nil {
-		return fmt.Errorf("can't get profile base policy for profile: %s", profile.Name)
+		return diag.Errorf("can't get profile base policy for profile: %s", profile.Name)
 	}
 	d.Set("base_rule", profileBase.BaseRule)
 
@@ -180,7 +212,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find profile: %s", err)
+		return diag.Errorf("couldn't find profile: %s", err)
 	}
 	d.Set("name", profile.Name)
 	log.Printf("[TRACE] Profile policy %v", profile.Policy)
@@ -222,7 +254,80 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+// profilePolicyWarnings returns a warning for each policy rule of the profile that fails the checks that
+// the controller doesn't enforce, and for each rule that contradicts a rule of another profile attached
+// to the same user. It is only called on create and update, since the conflict check lists all VPN users.
+func profilePolicyWarnings(ctx context.Context, client *goaviatrix.Client, profile *goaviatrix.Profile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	warnings, err := goaviatrix.CheckProfilePolicy(profile.BaseRule, profile.Policy)
+	if err != nil {
+		warnings = append(warnings, err.Error())
+	}
+	if len(warnings) != 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("VPN profile %s has policy rules that may not work as intended", profile.Name),
+			Detail:   strings.Join(warnings, "\n"),
+		})
+	}
+
+	if len(profile.UserList) == 0 {
+		return diags
+	}
+	vpnUsers, err := client.ListVPNUsers(ctx)
+	if err != nil {
+		log.Printf("[WARN] Could not list VPN users to check profile conflicts: %v", err)
+		return diags
+	}
+
+	profiles := make(map[string]*goaviatrix.Profile)
+	for _, vpnUser := range vpnUsers {
+		if !goaviatrix.Contains(profile.UserList, vpnUser.UserName) {
+			continue
+		}
+		var conflicts []string
+		for _, name := range vpnUser.Profiles {
+			if name == profile.Name {
+				continue
+			}
+			other, ok := profiles[name]
+			if !ok {
+				other, err = getProfileWithBaseRule(client, name)
+				if err != nil {
+					log.Printf("[WARN] Could not get VPN profile %s to check profile conflicts: %v", name, err)
+				}
+				profiles[name] = other
+			}
+			if other != nil {
+				conflicts = append(conflicts, goaviatrix.CheckProfileConflicts(profile, other)...)
+			}
+		}
+		if len(conflicts) != 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("VPN user %s is attached to profiles with contradicting rules", vpnUser.UserName),
+				Detail:   strings.Join(conflicts, "\n"),
+			})
+		}
+	}
+	return diags
+}
+
+func getProfileWithBaseRule(client *goaviatrix.Client, name string) (*goaviatrix.Profile, error) {
+	profileBase, err := client.GetProfileBasePolicy(&goaviatrix.Profile{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	profile, err := client.GetProfile(&goaviatrix.Profile{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	profile.BaseRule = profileBase.BaseRule
+	return profile, nil
+}
+
+func resourceAviatrixProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profile := &goaviatrix.Profile{
@@ -248,27 +353,21 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
-		dn := domain.(map[string]interface{})
-		profileRule := &goaviatrix.ProfileRule{
-			Action:   dn["action"].(string),
-			Protocol: dn["proto"].(string),
-			Port:     dn["port"].(string),
-			Target:   dn["target"].(string),
-		}
-		err := client.ValidateProfileRule(profileRule)
+		profileRule := expandProfileRule(domain.(map[string]interface{}))
+		err := goaviatrix.CheckProfileRule(profileRule)
 		if err != nil {
-			return fmt.Errorf("policy validation failed: %v", err)
+			return diag.Errorf("policy validation failed: %v", err)
 		}
-		profile.Policy = append(profile.Policy, *profileRule)
+		profile.Policy = append(profile.Policy, profileRule)
 	}
 
 	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
 
 	if d.HasChange("name") {
-		return fmt.Errorf("cannot change name of a profile")
+		return diag.Errorf("cannot change name of a profile")
 	}
 	if d.HasChange("base_rule") {
-		return fmt.Errorf("cannot change base rule of a profile")
+		return diag.Errorf("cannot change base rule of a profile")
 	}
 	if manageUserAttachment {
 		if d.HasChange("users") {
@@ -291,7 +390,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			profile.UserList = toAddUsers
 			err := client.AttachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User : %s", err)
+				return diag.Errorf("failed to attach User : %s", err)
 			}
 			//Detach all the removed Users
 			toDelGws := goaviatrix.Difference(oldUserList, newUserList)
@@ -299,12 +398,12 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			profile.UserList = toDelGws
 			err = client.DetachUsers(profile)
 			if err != nil {
-				return fmt.Errorf("failed to detach user : %s", err)
+				return diag.Errorf("failed to detach user : %s", err)
 			}
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
@@ -312,15 +411,22 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("policy") {
 		err := client.UpdateProfilePolicy(profile)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+			return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 		}
 	}
 
 	d.Partial(false)
-	return nil
+	checkPolicy := d.HasChanges("base_rule", "policy", "users")
+	diags := resourceAviatrixProfileRead(ctx, d, meta)
+	if diags.HasError() || !checkPolicy {
+		return diags
+	}
+	profile.BaseRule = d.Get("base_rule").(string)
+	profile.UserList = goaviatrix.ExpandStringList(d.Get("users").([]interface{}))
+	return append(diags, profilePolicyWarnings(ctx, client, profile)...)
 }
 
-func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*goaviatrix.Client)
 
 	profile := &goaviatrix.Profile{
@@ -333,13 +439,13 @@ func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) err
 		profile.UserList = goaviatrix.ExpandStringList(d.Get("users").([]interface{}))
 		err := client.DetachUsers(profile)
 		if err != nil {
-			return fmt.Errorf("failed to detach Users: %s", err)
+			return diag.Errorf("failed to detach Users: %s", err)
 		}
 	}
 
 	err := client.DeleteProfile(profile)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Profile: %s", err)
 	}
 
 	return nil
@@ -0,0 +1,140 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixVPNSessionDisconnect() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixVPNSessionDisconnectCreate,
+		ReadWithoutTimeout:   resourceAviatrixVPNSessionDisconnectRead,
+		UpdateWithoutTimeout: resourceAviatrixVPNSessionDisconnectUpdate,
+		DeleteWithoutTimeout: resourceAviatrixVPNSessionDisconnectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_name", "profile_name"},
+				Description:  "Name of the VPN user to disconnect.",
+			},
+			"profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the VPN profile whose users are all disconnected.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only disconnect sessions on this VPN gateway or load balancer.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will disconnect the users again.",
+			},
+			"disconnected_sessions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sessions disconnected by the last run.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPN user name.",
+						},
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VPN gateway or load balancer the user was connected to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func disconnectVPNSessions(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) error {
+	userName := d.Get("user_name").(string)
+	profileName := d.Get("profile_name").(string)
+	gwName := d.Get("gw_name").(string)
+
+	users := map[string]bool{userName: true}
+	if profileName != "" {
+		vpnUsers, err := client.ListVPNUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("could not list VPN users: %v", err)
+		}
+		users = make(map[string]bool)
+		for _, vpnUser := range vpnUsers {
+			if goaviatrix.Contains(vpnUser.Profiles, profileName) {
+				users[vpnUser.UserName] = true
+			}
+		}
+	}
+
+	vpnSessions, err := client.ListVPNSessions(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list VPN sessions: %v", err)
+	}
+
+	var disconnected []map[string]interface{}
+	for i := range vpnSessions {
+		session := &vpnSessions[i]
+		if !users[session.UserName] || (gwName != "" && session.GwName != gwName) {
+			continue
+		}
+		log.Printf("[INFO] Disconnecting VPN user %s from %s", session.UserName, session.GwName)
+		if err := client.DisconnectVPNSession(ctx, session); err != nil {
+			return fmt.Errorf("failed to disconnect VPN user %s from %s: %v", session.UserName, session.GwName, err)
+		}
+		disconnected = append(disconnected, map[string]interface{}{
+			"user_name": session.UserName,
+			"gw_name":   session.GwName,
+		})
+	}
+
+	if err := d.Set("disconnected_sessions", disconnected); err != nil {
+		return fmt.Errorf("couldn't set disconnected_sessions: %v", err)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNSessionDisconnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if err := disconnectVPNSessions(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId("vpn-session-disconnect-"))
+	return resourceAviatrixVPNSessionDisconnectRead(ctx, d, meta)
+}
+
+func resourceAviatrixVPNSessionDisconnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceAviatrixVPNSessionDisconnectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if err := disconnectVPNSessions(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAviatrixVPNSessionDisconnectRead(ctx, d, meta)
+}
+
+func resourceAviatrixVPNSessionDisconnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,102 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixVPNSessionDisconnect_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_vpn_session_disconnect.test"
+
+	skipAcc := os.Getenv("SKIP_VPN_SESSION_DISCONNECT")
+	if skipAcc == "yes" {
+		t.Skip("Skipping VPN Session Disconnect test as SKIP_VPN_SESSION_DISCONNECT is set")
+	}
+	msg := ". Set SKIP_VPN_SESSION_DISCONNECT to yes to skip VPN Session Disconnect tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNSessionDisconnectConfigBasic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNSessionDisconnectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disconnected_sessions.#", "0"),
+				),
+			},
+			{
+				Config: testAccVPNSessionDisconnectConfigBasic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNSessionDisconnectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNSessionDisconnectConfigBasic(rName string, run string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_account.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+	vpn_access   = true
+	vpn_cidr     = "192.168.43.0/24"
+	max_vpn_conn = "100"
+	enable_elb   = true
+	elb_name     = "tfl-%[1]s"
+}
+resource "aviatrix_vpn_user" "test_vpn_user" {
+	vpc_id     = aviatrix_gateway.test_gw.vpc_id
+	gw_name    = aviatrix_gateway.test_gw.elb_name
+	user_name  = "tfu-%[1]s"
+	user_email = "user@xyz.com"
+}
+resource "aviatrix_vpn_session_disconnect" "test" {
+	user_name = aviatrix_vpn_user.test_vpn_user.user_name
+	gw_name   = aviatrix_gateway.test_gw.elb_name
+
+	triggers = {
+		run = "%[8]s"
+	}
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), run)
+}
+
+func testAccCheckVPNSessionDisconnectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN Session Disconnect Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no VPN Session Disconnect ID is set")
+		}
+
+		return nil
+	}
+}
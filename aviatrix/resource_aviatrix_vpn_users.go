@@ -0,0 +1,516 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixVPNUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixVPNUsersCreate,
+		ReadWithoutTimeout:   resourceAviatrixVPNUsersRead,
+		UpdateWithoutTimeout: resourceAviatrixVPNUsersUpdate,
+		DeleteWithoutTimeout: resourceAviatrixVPNUsersDelete,
+		CustomizeDiff:        resourceAviatrixVPNUsersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"users_file"},
+				Description:   "Set of VPN users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "VPN user name.",
+						},
+						"user_email": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "VPN user's email.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "VPC ID of the Aviatrix VPN gateway.",
+						},
+						"gw_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "If ELB is enabled, this will be the name of the ELB, " +
+								"else it will be the name of the Aviatrix VPN gateway.",
+						},
+						"dns_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "FQDN of a DNS based VPN service such as GeoVPN or UDP load balancer.",
+						},
+						"saml_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the SAML endpoint the user is associated with.",
+						},
+						"profiles": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Set of profiles to attach the user to.",
+						},
+					},
+				},
+			},
+			"users_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"users"},
+				Description:   "Path to a CSV file of VPN users.",
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Number of users added, removed or updated concurrently.",
+			},
+			"email_new_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Email VPN certificates to newly added users that have a 'user_email'.",
+			},
+			"adopt_existing_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage added users that already exist on the controller instead of failing.",
+			},
+			"adopted_user_names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the users that already existed on the controller when they were added.",
+			},
+		},
+	}
+}
+
+func marshalVPNUsersInput(users *schema.Set) []goaviatrix.VPNUser {
+	var vpnUsers []goaviatrix.VPNUser
+	for _, v := range users.List() {
+		user := v.(map[string]interface{})
+		vpnUser := goaviatrix.VPNUser{
+			UserName:     user["user_name"].(string),
+			UserEmail:    user["user_email"].(string),
+			VpcID:        user["vpc_id"].(string),
+			GwName:       user["gw_name"].(string),
+			DnsName:      user["dns_name"].(string),
+			SamlEndpoint: user["saml_endpoint"].(string),
+			Profiles:     goaviatrix.ExpandStringList(user["profiles"].(*schema.Set).List()),
+		}
+		vpnUser.DnsEnabled = vpnUser.DnsName != ""
+		vpnUsers = append(vpnUsers, vpnUser)
+	}
+	return vpnUsers
+}
+
+func flattenVPNUsers(vpnUsers []goaviatrix.VPNUser) []interface{} {
+	var users []interface{}
+	for _, vpnUser := range vpnUsers {
+		user := map[string]interface{}{
+			"user_name":     vpnUser.UserName,
+			"user_email":    vpnUser.UserEmail,
+			"saml_endpoint": vpnUser.SamlEndpoint,
+			"profiles":      vpnUser.Profiles,
+		}
+		if vpnUser.DnsEnabled {
+			user["dns_name"] = vpnUser.DnsName
+		} else {
+			user["vpc_id"] = vpnUser.VpcID
+			user["gw_name"] = vpnUser.GwName
+		}
+		users = append(users, user)
+	}
+	return users
+}
+
+func validateVPNUsers(vpnUsers []goaviatrix.VPNUser) error {
+	seen := make(map[string]bool)
+	for _, vpnUser := range vpnUsers {
+		if seen[vpnUser.UserName] {
+			return fmt.Errorf("duplicate VPN user %q", vpnUser.UserName)
+		}
+		seen[vpnUser.UserName] = true
+
+		if vpnUser.DnsName == "" {
+			if vpnUser.VpcID == "" || vpnUser.GwName == "" {
+				return fmt.Errorf("VPN user %q: please set both 'vpc_id' and 'gw_name', or 'dns_name' alone", vpnUser.UserName)
+			}
+		} else if vpnUser.VpcID != "" || vpnUser.GwName != "" {
+			return fmt.Errorf("VPN user %q: DNS is enabled. Please set 'vpc_id' and 'gw_name' to be empty", vpnUser.UserName)
+		}
+	}
+	return nil
+}
+
+// vpnUserNeedsReplace returns true when the user must be deleted and added again,
+// since only profile attachments can be changed in place.
+func vpnUserNeedsReplace(o, n goaviatrix.VPNUser) bool {
+	return o.UserEmail != n.UserEmail || o.VpcID != n.VpcID || o.GwName != n.GwName ||
+		o.DnsName != n.DnsName || o.SamlEndpoint != n.SamlEndpoint
+}
+
+// runVPNUserBatches calls f for each user, running up to batchSize calls concurrently.
+func runVPNUserBatches(users []goaviatrix.VPNUser, batchSize int, f func(goaviatrix.VPNUser) error) []error {
+	var errs []error
+	for start := 0; start < len(users); start += batchSize {
+		end := start + batchSize
+		if end > len(users) {
+			end = len(users)
+		}
+
+		batchErrs := make([]error, end-start)
+		var wg sync.WaitGroup
+		for i, user := range users[start:end] {
+			wg.Add(1)
+			go func(i int, user goaviatrix.VPNUser) {
+				defer wg.Done()
+				batchErrs[i] = f(user)
+			}(i, user)
+		}
+		wg.Wait()
+
+		for _, err := range batchErrs {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// adoptExistingVPNUsers checks the added users that already exist on the controller. They are only managed
+// when adoptExisting is set, in which case they are recorded in adopted and returned with oldUsers, so that
+// only their profiles are updated. Adopted users were not created by this resource, so an error is returned
+// instead of deleting and re-adding them when their attributes differ.
+func adoptExistingVPNUsers(ctx context.Context, client *goaviatrix.Client, oldUsers, newUsers []goaviatrix.VPNUser, adopted map[string]bool, adoptExisting bool) ([]goaviatrix.VPNUser, error) {
+	oldByName := make(map[string]bool)
+	for _, user := range oldUsers {
+		oldByName[user.UserName] = true
+	}
+	newByName := make(map[string]goaviatrix.VPNUser)
+	var added []string
+	for _, user := range newUsers {
+		newByName[user.UserName] = user
+		if !oldByName[user.UserName] {
+			added = append(added, user.UserName)
+		}
+	}
+
+	users := append([]goaviatrix.VPNUser{}, oldUsers...)
+	if len(added) != 0 {
+		vpnUsers, err := client.ListVPNUsers(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't list VPN users: %v", err)
+		}
+		existing := make(map[string]goaviatrix.VPNUser)
+		for _, vpnUser := range vpnUsers {
+			existing[vpnUser.UserName] = vpnUser
+		}
+		var existingNames []string
+		for _, userName := range added {
+			if _, ok := existing[userName]; ok {
+				existingNames = append(existingNames, userName)
+			}
+		}
+		if len(existingNames) != 0 && !adoptExisting {
+			return nil, fmt.Errorf("VPN users %s already exist on the controller. Remove them from this resource "+
+				"or set 'adopt_existing_users' to true to manage them", strings.Join(existingNames, ", "))
+		}
+		for _, userName := range existingNames {
+			users = append(users, existing[userName])
+			adopted[userName] = true
+		}
+	}
+
+	for i, o := range users {
+		n, ok := newByName[o.UserName]
+		if !ok || !adopted[o.UserName] {
+			continue
+		}
+		// The email of an adopted user is only used to send its certificate, which was done when it was created.
+		o.UserEmail = n.UserEmail
+		if vpnUserNeedsReplace(o, n) {
+			return nil, fmt.Errorf("VPN user %s was not created by this resource, so only its 'profiles' can be changed. "+
+				"Please keep its 'vpc_id', 'gw_name', 'dns_name' and 'saml_endpoint' as they are on the controller", o.UserName)
+		}
+		users[i] = o
+	}
+	return users, nil
+}
+
+func reconcileVPNUsers(ctx context.Context, client *goaviatrix.Client, oldUsers, newUsers []goaviatrix.VPNUser, batchSize int, emailNewUsers bool) error {
+	oldByName := make(map[string]goaviatrix.VPNUser)
+	for _, user := range oldUsers {
+		oldByName[user.UserName] = user
+	}
+	newByName := make(map[string]goaviatrix.VPNUser)
+	for _, user := range newUsers {
+		newByName[user.UserName] = user
+	}
+
+	var toDelete, toCreate, toUpdate []goaviatrix.VPNUser
+	for _, o := range oldUsers {
+		n, ok := newByName[o.UserName]
+		if !ok || vpnUserNeedsReplace(o, n) {
+			toDelete = append(toDelete, o)
+		}
+	}
+	for _, n := range newUsers {
+		o, ok := oldByName[n.UserName]
+		if !ok || vpnUserNeedsReplace(o, n) {
+			toCreate = append(toCreate, n)
+		} else if !goaviatrix.Equivalent(o.Profiles, n.Profiles) {
+			toUpdate = append(toUpdate, n)
+		}
+	}
+
+	log.Printf("[INFO] Reconciling VPN users: %d to delete, %d to create, %d to update profiles",
+		len(toDelete), len(toCreate), len(toUpdate))
+
+	errs := runVPNUserBatches(toDelete, batchSize, func(user goaviatrix.VPNUser) error {
+		err := client.DeleteVPNUser(&user)
+		if err != nil {
+			return fmt.Errorf("failed to delete VPN user %s: %v", user.UserName, err)
+		}
+		return nil
+	})
+
+	errs = append(errs, runVPNUserBatches(toCreate, batchSize, func(user goaviatrix.VPNUser) error {
+		if !emailNewUsers {
+			user.UserEmail = ""
+		}
+		err := client.CreateVPNUser(&user)
+		if err != nil {
+			return fmt.Errorf("failed to create VPN user %s: %v", user.UserName, err)
+		}
+		for _, profileName := range user.Profiles {
+			profile := &goaviatrix.Profile{
+				Name:     profileName,
+				UserList: []string{user.UserName},
+			}
+			if err := client.AttachUsers(profile); err != nil {
+				return fmt.Errorf("failed to attach VPN user %s to profile %s: %v", user.UserName, profileName, err)
+			}
+		}
+		return nil
+	})...)
+
+	errs = append(errs, runVPNUserBatches(toUpdate, batchSize, func(user goaviatrix.VPNUser) error {
+		oldProfiles := oldByName[user.UserName].Profiles
+		for _, profileName := range goaviatrix.Difference(user.Profiles, oldProfiles) {
+			profile := &goaviatrix.Profile{
+				Name:     profileName,
+				UserList: []string{user.UserName},
+			}
+			if err := client.AttachUsers(profile); err != nil {
+				return fmt.Errorf("failed to attach VPN user %s to profile %s: %v", user.UserName, profileName, err)
+			}
+		}
+		for _, profileName := range goaviatrix.Difference(oldProfiles, user.Profiles) {
+			profile := &goaviatrix.Profile{
+				Name:     profileName,
+				UserList: []string{user.UserName},
+			}
+			if err := client.DetachUsers(profile); err != nil {
+				return fmt.Errorf("failed to detach VPN user %s from profile %s: %v", user.UserName, profileName, err)
+			}
+		}
+		return nil
+	})...)
+
+	if len(errs) != 0 {
+		var msgs []string
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+		return fmt.Errorf("%d VPN user operations failed:\n%s", len(errs), strings.Join(msgs, "\n"))
+	}
+	return nil
+}
+
+// setVPNUsers refreshes the given users from the controller. Users that no longer exist are dropped.
+// Emails are kept from the given users, since they are not stored for users that were not emailed.
+func setVPNUsers(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, users []goaviatrix.VPNUser, adopted map[string]bool) error {
+	vpnUsers, err := client.ListVPNUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't list VPN users: %v", err)
+	}
+	found := make(map[string]goaviatrix.VPNUser)
+	for _, vpnUser := range vpnUsers {
+		found[vpnUser.UserName] = vpnUser
+	}
+
+	var refreshed []goaviatrix.VPNUser
+	var adoptedUserNames []string
+	for _, user := range users {
+		vu, ok := found[user.UserName]
+		if !ok {
+			continue
+		}
+		if vu.UserEmail == "" || adopted[vu.UserName] {
+			vu.UserEmail = user.UserEmail
+		}
+		refreshed = append(refreshed, vu)
+		if adopted[vu.UserName] {
+			adoptedUserNames = append(adoptedUserNames, vu.UserName)
+		}
+	}
+
+	if err := d.Set("users", flattenVPNUsers(refreshed)); err != nil {
+		return fmt.Errorf("couldn't set users: %v", err)
+	}
+	if err := d.Set("adopted_user_names", adoptedUserNames); err != nil {
+		return fmt.Errorf("couldn't set adopted_user_names: %v", err)
+	}
+	return nil
+}
+
+func getVPNUsersAdopted(d *schema.ResourceData) map[string]bool {
+	adopted := make(map[string]bool)
+	for _, userName := range getStringSet(d, "adopted_user_names") {
+		adopted[userName] = true
+	}
+	return adopted
+}
+
+func resourceAviatrixVPNUsersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if path := d.Get("users_file").(string); path != "" {
+		vpnUsers, err := goaviatrix.ReadVPNUsersFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read users_file %q: %v", path, err)
+		}
+		if err := validateVPNUsers(vpnUsers); err != nil {
+			return err
+		}
+		return d.SetNew("users", flattenVPNUsers(vpnUsers))
+	}
+
+	if d.NewValueKnown("users") {
+		return validateVPNUsers(marshalVPNUsersInput(d.Get("users").(*schema.Set)))
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpnUsers := marshalVPNUsersInput(d.Get("users").(*schema.Set))
+	if err := validateVPNUsers(vpnUsers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	adopted := make(map[string]bool)
+	oldUsers, err := adoptExistingVPNUsers(ctx, client, nil, vpnUsers, adopted, d.Get("adopt_existing_users").(bool))
+	if err != nil {
+		return diag.Errorf("failed to create VPN users: %v", err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId("vpn-users-"))
+
+	err = reconcileVPNUsers(ctx, client, oldUsers, vpnUsers, d.Get("batch_size").(int), d.Get("email_new_users").(bool))
+	if err != nil {
+		// Keep track of the users that were created, so they are retried or cleaned up on the next apply.
+		if setErr := setVPNUsers(ctx, d, client, vpnUsers, adopted); setErr != nil {
+			log.Printf("[WARN] %v", setErr)
+		}
+		return diag.Errorf("failed to create VPN users: %v", err)
+	}
+
+	if err := setVPNUsers(ctx, d, client, vpnUsers, adopted); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpnUsers := marshalVPNUsersInput(d.Get("users").(*schema.Set))
+	if err := setVPNUsers(ctx, d, client, vpnUsers, getVPNUsersAdopted(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	if d.HasChange("users") {
+		o, n := d.GetChange("users")
+		oldUsers := marshalVPNUsersInput(o.(*schema.Set))
+		newUsers := marshalVPNUsersInput(n.(*schema.Set))
+		if err := validateVPNUsers(newUsers); err != nil {
+			return diag.FromErr(err)
+		}
+
+		adopted := getVPNUsersAdopted(d)
+		managedUsers, err := adoptExistingVPNUsers(ctx, client, oldUsers, newUsers, adopted, d.Get("adopt_existing_users").(bool))
+		if err != nil {
+			return diag.Errorf("failed to update VPN users: %v", err)
+		}
+
+		err = reconcileVPNUsers(ctx, client, managedUsers, newUsers, d.Get("batch_size").(int), d.Get("email_new_users").(bool))
+		if err != nil {
+			// Track both old and new users, so that users which failed to be removed are not forgotten.
+			tracked := newUsers
+			for _, user := range oldUsers {
+				if !containsVPNUser(newUsers, user.UserName) {
+					tracked = append(tracked, user)
+				}
+			}
+			if setErr := setVPNUsers(ctx, d, client, tracked, adopted); setErr != nil {
+				log.Printf("[WARN] %v", setErr)
+			}
+			return diag.Errorf("failed to update VPN users: %v", err)
+		}
+		if err := setVPNUsers(ctx, d, client, newUsers, adopted); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	return resourceAviatrixVPNUsersRead(ctx, d, meta)
+}
+
+func containsVPNUser(users []goaviatrix.VPNUser, userName string) bool {
+	for _, user := range users {
+		if user.UserName == userName {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceAviatrixVPNUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*goaviatrix.Client)
+
+	vpnUsers := marshalVPNUsersInput(d.Get("users").(*schema.Set))
+	err := reconcileVPNUsers(ctx, client, vpnUsers, nil, d.Get("batch_size").(int), false)
+	if err != nil {
+		return diag.Errorf("failed to delete VPN users: %v", err)
+	}
+
+	return nil
+}
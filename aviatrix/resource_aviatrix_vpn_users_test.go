@@ -0,0 +1,152 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixVPNUsers_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_vpn_users.test_vpn_users"
+
+	skipAcc := os.Getenv("SKIP_VPN_USERS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping VPN Users test as SKIP_VPN_USERS is set")
+	}
+	msg := ". Set SKIP_VPN_USERS to yes to skip VPN Users tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPNUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNUsersConfigBasic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "adopted_user_names.#", "0"),
+				),
+			},
+			{
+				Config: testAccVPNUsersConfigBasic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNUsersConfigBasic(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_account.account_name
+	gw_name      = "tfg-%[1]s"
+	vpc_id       = "%[5]s"
+	vpc_reg      = "%[6]s"
+	gw_size      = "t2.micro"
+	subnet       = "%[7]s"
+	vpn_access   = true
+	vpn_cidr     = "192.168.43.0/24"
+	max_vpn_conn = "100"
+	enable_elb   = true
+	elb_name     = "tfl-%[1]s"
+}
+resource "aviatrix_vpn_users" "test_vpn_users" {
+	email_new_users = false
+
+	dynamic "users" {
+		for_each = range(%[8]d)
+		content {
+			vpc_id     = aviatrix_gateway.test_gw.vpc_id
+			gw_name    = aviatrix_gateway.test_gw.elb_name
+			user_name  = "tfu-%[1]s-${users.value}"
+			user_email = "user${users.value}@xyz.com"
+		}
+	}
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), count)
+}
+
+func testAccCheckVPNUsersExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN Users Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no VPN Users ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		vpnUsers, err := client.ListVPNUsers(context.Background())
+		if err != nil {
+			return err
+		}
+		found := make(map[string]bool)
+		for _, vpnUser := range vpnUsers {
+			found[vpnUser.UserName] = true
+		}
+		for k, v := range rs.Primary.Attributes {
+			if isVPNUsersUserNameAttribute(k) && !found[v] {
+				return fmt.Errorf("VPN user %s not found", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPNUsersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_vpn_users" {
+			continue
+		}
+
+		vpnUsers, err := client.ListVPNUsers(context.Background())
+		if err != nil {
+			return err
+		}
+		found := make(map[string]bool)
+		for _, vpnUser := range vpnUsers {
+			found[vpnUser.UserName] = true
+		}
+		for k, v := range rs.Primary.Attributes {
+			if isVPNUsersUserNameAttribute(k) && found[v] {
+				return fmt.Errorf("VPN user %s still exists", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isVPNUsersUserNameAttribute(k string) bool {
+	return strings.HasPrefix(k, "users.") && strings.HasSuffix(k, ".user_name")
+}
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -376,3 +377,72 @@ func mapContains(m map[string]interface{}, key string) bool {
 		return !reflect.ValueOf(val).IsZero()
 	}
 }
+
+// getAccountAuditResult returns the latest audit result of an access account. It is a variable so that tests can
+// replace it.
+var getAccountAuditResult = func(ctx context.Context, client *goaviatrix.Client, accountName string) (*goaviatrix.AccountAuditResult, error) {
+	return client.GetAccountAuditResult(ctx, accountName)
+}
+
+// customizeDiffAccountAudit is a CustomizeDiffFunc for gateway resources with an 'account_name' argument. When
+// 'enforce_account_audit' is enabled in the provider configuration, it fails plans that create or change a gateway
+// whose access account failed its audit.
+func customizeDiffAccountAudit(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+	if !client.EnforceAccountAudit {
+		return nil
+	}
+	if diff.Id() != "" && len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	return checkAccountAudit(ctx, client, diff.Get("account_name").(string))
+}
+
+// customizeDiffPrimaryGatewayAccountAudit is a CustomizeDiffFunc for HA gateway resources, which use the access
+// account of their primary gateway. It is the same as customizeDiffAccountAudit otherwise.
+func customizeDiffPrimaryGatewayAccountAudit(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*goaviatrix.Client)
+	if !client.EnforceAccountAudit {
+		return nil
+	}
+	if diff.Id() != "" && len(diff.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	accountName := diff.Get("account_name").(string)
+	if accountName == "" {
+		primaryGwName := diff.Get("primary_gw_name").(string)
+		if primaryGwName == "" {
+			return nil
+		}
+		// the primary gateway may be created in the same apply, its account is checked by its own plan then
+		primaryGateway, err := client.GetGateway(&goaviatrix.Gateway{GwName: primaryGwName})
+		if err != nil {
+			log.Printf("[WARN] Could not get access account of primary gateway %s to check its audit: %v", primaryGwName, err)
+			return nil
+		}
+		accountName = primaryGateway.AccountName
+	}
+
+	return checkAccountAudit(ctx, client, accountName)
+}
+
+// checkAccountAudit returns an error if 'enforce_account_audit' is enabled and the access account failed its audit.
+func checkAccountAudit(ctx context.Context, client *goaviatrix.Client, accountName string) error {
+	if !client.EnforceAccountAudit || accountName == "" {
+		return nil
+	}
+
+	accountAuditResult, err := getAccountAuditResult(ctx, client, accountName)
+	if err != nil {
+		if err == goaviatrix.ErrNotFound {
+			return nil
+		}
+		return fmt.Errorf("could not get audit status of account %s: %v", accountName, err)
+	}
+	if !accountAuditResult.Passed() {
+		return fmt.Errorf("account %s failed its audit: %s. Fix the account or disable 'enforce_account_audit' in the provider configuration", accountName, accountAuditResult.Comment)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v2/goaviatrix"
+)
+
+func TestCheckAccountAudit(t *testing.T) {
+	tt := []struct {
+		Name                string
+		EnforceAccountAudit bool
+		AccountName         string
+		AuditResult         *goaviatrix.AccountAuditResult
+		AuditErr            error
+		ExpectedLookup      bool
+		ExpectedErr         string
+	}{
+		{
+			Name:        "disabled",
+			AccountName: "aws-account",
+			AuditResult: &goaviatrix.AccountAuditResult{Status: "Fail", Comment: "missing permissions"},
+		},
+		{
+			Name:                "enabled without account",
+			EnforceAccountAudit: true,
+		},
+		{
+			Name:                "passed",
+			EnforceAccountAudit: true,
+			AccountName:         "aws-account",
+			AuditResult:         &goaviatrix.AccountAuditResult{Status: "Pass"},
+			ExpectedLookup:      true,
+		},
+		{
+			Name:                "failed",
+			EnforceAccountAudit: true,
+			AccountName:         "aws-account",
+			AuditResult:         &goaviatrix.AccountAuditResult{Status: "Fail", Comment: "missing permissions"},
+			ExpectedLookup:      true,
+			ExpectedErr:         "account aws-account failed its audit: missing permissions",
+		},
+		{
+			Name:                "no audit record",
+			EnforceAccountAudit: true,
+			AccountName:         "aws-account",
+			AuditErr:            goaviatrix.ErrNotFound,
+			ExpectedLookup:      true,
+		},
+		{
+			Name:                "lookup error",
+			EnforceAccountAudit: true,
+			AccountName:         "aws-account",
+			AuditErr:            errors.New("rest API get_account_audit_records failed"),
+			ExpectedLookup:      true,
+			ExpectedErr:         "could not get audit status of account aws-account",
+		},
+	}
+
+	defer func(f func(context.Context, *goaviatrix.Client, string) (*goaviatrix.AccountAuditResult, error)) {
+		getAccountAuditResult = f
+	}(getAccountAuditResult)
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			lookedUp := false
+			getAccountAuditResult = func(ctx context.Context, client *goaviatrix.Client, accountName string) (*goaviatrix.AccountAuditResult, error) {
+				lookedUp = true
+				if accountName != test.AccountName {
+					t.Errorf("expected audit lookup of account %s, got %s", test.AccountName, accountName)
+				}
+				return test.AuditResult, test.AuditErr
+			}
+
+			client := &goaviatrix.Client{EnforceAccountAudit: test.EnforceAccountAudit}
+			err := checkAccountAudit(context.Background(), client, test.AccountName)
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if lookedUp != test.ExpectedLookup {
+				t.Errorf("expected audit lookup to be %t, got %t", test.ExpectedLookup, lookedUp)
+			}
+		})
+	}
+}
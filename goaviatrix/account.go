@@ -3,6 +3,7 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -363,18 +364,28 @@ func (c *Client) UploadOciApiPrivateKeyFile(account *Account) error {
 	return c.PostAPI(account.Action, account, BasicCheck)
 }
 
-func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
+type AccountAuditResult struct {
+	AccountName        string   `json:"account_name"`
+	CloudType          int      `json:"cloud_type"`
+	Status             string   `json:"status"`
+	Comment            string   `json:"comment"`
+	AuditTime          string   `json:"audit_time"`
+	RoleStatus         string   `json:"role_status"`
+	PolicyStatus       string   `json:"policy_status"`
+	MissingPermissions []string `json:"missing_permissions"`
+}
+
+// Passed returns whether the account passed the audit.
+func (r *AccountAuditResult) Passed() bool {
+	return strings.Contains(r.Status, "Pass")
+}
+
+func (c *Client) ListAccountAuditResults(ctx context.Context) ([]AccountAuditResult, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_account_audit_records",
 	}
 
-	type AccountAuditResult struct {
-		AccountName string `json:"account_name"`
-		Status      string `json:"status"`
-		Comment     string `json:"comment"`
-	}
-
 	type AccountAuditResponse struct {
 		Return  bool                 `json:"return"`
 		Results []AccountAuditResult `json:"results"`
@@ -383,17 +394,64 @@ func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
 	var resp AccountAuditResponse
 	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return resp.Results, nil
+}
+
+func (c *Client) GetAccountAuditResult(ctx context.Context, accountName string) (*AccountAuditResult, error) {
+	accountAuditResults, err := c.ListAccountAuditResults(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range accountAuditResults {
+		if accountAuditResults[i].AccountName == accountName {
+			return &accountAuditResults[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
 
-	for _, accountAuditResult := range resp.Results {
-		if accountAuditResult.AccountName == account.AccountName && !strings.Contains(accountAuditResult.Status, "Pass") {
-			return fmt.Errorf("%s", accountAuditResult.Comment)
+func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
+	accountAuditResult, err := c.GetAccountAuditResult(ctx, account.AccountName)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil
 		}
+		return err
+	}
+
+	if !accountAuditResult.Passed() {
+		return fmt.Errorf("%s", accountAuditResult.Comment)
 	}
 	return nil
 }
 
+// ListAccountGatewayNames returns the names of all gateways launched with the given access account.
+func (c *Client) ListAccountGatewayNames(ctx context.Context, accountName string) ([]string, error) {
+	action := "list_vpcs_summary"
+	params := map[string]string{
+		"CID":    c.CID,
+		"action": action,
+	}
+
+	var data GatewayListResp
+	err := c.GetAPIContext(ctx, &data, action, params, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	var gwNames []string
+	for _, gw := range data.Results {
+		if gw.AccountName == accountName {
+			gwNames = append(gwNames, gw.GwName)
+		}
+	}
+	sort.Strings(gwNames)
+	return gwNames, nil
+}
+
 func (c *Client) CreateEdgeCSPAccount(edgeCSPAccount *EdgeCSPAccount) error {
 	edgeCSPAccount.CID = c.CID
 	edgeCSPAccount.Action = "setup_account_profile"
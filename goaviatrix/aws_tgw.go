@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -51,6 +52,7 @@ type RouteDomainDetail struct {
 	Associations           []string             `json:"associations"`
 	Name                   string               `json:"name"`
 	ConnectedRouteDomain   []string             `json:"connected_route_domain"`
+	Propagations           []string             `json:"propagations"`
 	AttachedVPC            []AttachedVPCDetail  `json:"attached_vpc"`
 	RoutesInRouteTable     []RoutesInRouteTable `json:"routes_in_route_table"`
 	RouteTableId           string               `json:"route_table_id"`
@@ -599,6 +601,54 @@ func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName s
 	return &data.Results, nil
 }
 
+func (c *Client) GetAwsTgwRouteTables(ctx context.Context, tgwName string) ([]RouteDomainDetail, error) {
+	form := map[string]string{
+		"CID":      c.CID,
+		"action":   "list_route_domain_names",
+		"tgw_name": tgwName,
+	}
+	check := func(action, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "does not exist") {
+				return ErrNotFound
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
+		}
+		return nil
+	}
+	var data AWSTgwAPIResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames := append([]string{"Aviatrix_Edge_Domain"}, data.Results...)
+
+	var routeTables []RouteDomainDetail
+	for _, dm := range domainNames {
+		if strings.HasPrefix(dm, "peering_") || strings.Contains(dm, ":") {
+			continue
+		}
+
+		form = map[string]string{
+			"CID":               c.CID,
+			"action":            "view_route_domain_details",
+			"tgw_name":          tgwName,
+			"route_domain_name": dm,
+		}
+		var data1 RouteDomainAPIResp
+		err = c.GetAPIContext(ctx, &data1, form["action"], form, BasicCheck)
+		if err != nil {
+			return nil, err
+		}
+		if len(data1.Results) == 0 {
+			continue
+		}
+		routeTables = append(routeTables, data1.Results[0])
+	}
+	return routeTables, nil
+}
+
 func (c *Client) UpdateTGWCidrs(tgwName string, cidrs []string) error {
 	data := map[string]string{
 		"action":    "update_tgw_cidrs",
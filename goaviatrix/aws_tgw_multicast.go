@@ -0,0 +1,168 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type AwsTgwMulticastDomain struct {
+	Action                       string `form:"action"`
+	CID                          string `form:"CID"`
+	TgwName                      string `form:"tgw_name" json:"tgw_name"`
+	DomainName                   string `form:"multicast_domain_name" json:"multicast_domain_name"`
+	MulticastDomainID            string `json:"multicast_domain_id"`
+	Igmpv2Support                bool   `form:"igmpv2_support" json:"igmpv2_support"`
+	StaticSourcesSupport         bool   `form:"static_sources_support" json:"static_sources_support"`
+	AutoAcceptSharedAssociations bool   `form:"auto_accept_shared_associations" json:"auto_accept_shared_associations"`
+	Async                        bool   `form:"async,omitempty"`
+}
+
+func (a *AwsTgwMulticastDomain) ID() string {
+	return fmt.Sprintf("%s~~%s", a.TgwName, a.DomainName)
+}
+
+type AwsTgwMulticastDomainAssociation struct {
+	Action              string   `form:"action"`
+	CID                 string   `form:"CID"`
+	TgwName             string   `form:"tgw_name" json:"tgw_name"`
+	MulticastDomainName string   `form:"multicast_domain_name" json:"multicast_domain_name"`
+	VpcID               string   `form:"vpc_id" json:"vpc_id"`
+	AttachmentID        string   `json:"attachment_id"`
+	SubnetIDs           []string `json:"subnet_ids"`
+	SubnetIDsString     string   `form:"subnet_ids"`
+}
+
+func (a *AwsTgwMulticastDomainAssociation) ID() string {
+	return fmt.Sprintf("%s~~%s~~%s", a.TgwName, a.MulticastDomainName, a.VpcID)
+}
+
+type AwsTgwMulticastGroupMember struct {
+	Action                  string   `form:"action"`
+	CID                     string   `form:"CID"`
+	TgwName                 string   `form:"tgw_name" json:"tgw_name"`
+	MulticastDomainName     string   `form:"multicast_domain_name" json:"multicast_domain_name"`
+	GroupIPAddress          string   `form:"group_ip_address" json:"group_ip_address"`
+	MemberType              string   `form:"member_type" json:"member_type"`
+	NetworkInterfaceIDs     []string `json:"network_interface_ids"`
+	NetworkInterfaceIDsText string   `form:"network_interface_ids"`
+}
+
+func (a *AwsTgwMulticastGroupMember) ID() string {
+	return fmt.Sprintf("%s~~%s~~%s~~%s", a.TgwName, a.MulticastDomainName, a.GroupIPAddress, a.MemberType)
+}
+
+func awsTgwMulticastCheck(action, method, reason string, ret bool) error {
+	if !ret {
+		if strings.Contains(reason, "does not exist") || strings.Contains(reason, "not found") {
+			return ErrNotFound
+		}
+		return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
+	}
+	return nil
+}
+
+func (c *Client) CreateTgwMulticastDomain(ctx context.Context, domain *AwsTgwMulticastDomain) error {
+	domain.Action = "create_tgw_multicast_domain"
+	domain.CID = c.CID
+	domain.Async = true
+	return c.PostAsyncAPIContext(ctx, domain.Action, domain, BasicCheck)
+}
+
+func (c *Client) GetTgwMulticastDomain(ctx context.Context, domain *AwsTgwMulticastDomain) (*AwsTgwMulticastDomain, error) {
+	form := map[string]string{
+		"action":                "get_tgw_multicast_domain",
+		"CID":                   c.CID,
+		"tgw_name":              domain.TgwName,
+		"multicast_domain_name": domain.DomainName,
+	}
+
+	var data struct {
+		Results AwsTgwMulticastDomain
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, awsTgwMulticastCheck)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Results, nil
+}
+
+func (c *Client) DeleteTgwMulticastDomain(ctx context.Context, domain *AwsTgwMulticastDomain) error {
+	domain.Action = "delete_tgw_multicast_domain"
+	domain.CID = c.CID
+	domain.Async = true
+	return c.PostAsyncAPIContext(ctx, domain.Action, domain, BasicCheck)
+}
+
+func (c *Client) AssociateTgwMulticastDomain(ctx context.Context, association *AwsTgwMulticastDomainAssociation) error {
+	association.Action = "associate_tgw_multicast_domain"
+	association.CID = c.CID
+	association.SubnetIDsString = strings.Join(association.SubnetIDs, ",")
+	return c.PostAPIContext(ctx, association.Action, association, BasicCheck)
+}
+
+func (c *Client) GetTgwMulticastDomainAssociation(ctx context.Context, association *AwsTgwMulticastDomainAssociation) (*AwsTgwMulticastDomainAssociation, error) {
+	form := map[string]string{
+		"action":                "get_tgw_multicast_domain_association",
+		"CID":                   c.CID,
+		"tgw_name":              association.TgwName,
+		"multicast_domain_name": association.MulticastDomainName,
+		"vpc_id":                association.VpcID,
+	}
+
+	var data struct {
+		Results AwsTgwMulticastDomainAssociation
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, awsTgwMulticastCheck)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Results.SubnetIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	return &data.Results, nil
+}
+
+func (c *Client) DisassociateTgwMulticastDomain(ctx context.Context, association *AwsTgwMulticastDomainAssociation) error {
+	association.Action = "disassociate_tgw_multicast_domain"
+	association.CID = c.CID
+	association.SubnetIDsString = strings.Join(association.SubnetIDs, ",")
+	return c.PostAPIContext(ctx, association.Action, association, BasicCheck)
+}
+
+func (c *Client) RegisterTgwMulticastGroupMembers(ctx context.Context, member *AwsTgwMulticastGroupMember) error {
+	member.Action = "register_tgw_multicast_group_members"
+	member.CID = c.CID
+	member.NetworkInterfaceIDsText = strings.Join(member.NetworkInterfaceIDs, ",")
+	return c.PostAPIContext(ctx, member.Action, member, BasicCheck)
+}
+
+func (c *Client) GetTgwMulticastGroupMembers(ctx context.Context, member *AwsTgwMulticastGroupMember) (*AwsTgwMulticastGroupMember, error) {
+	form := map[string]string{
+		"action":                "get_tgw_multicast_group_members",
+		"CID":                   c.CID,
+		"tgw_name":              member.TgwName,
+		"multicast_domain_name": member.MulticastDomainName,
+		"group_ip_address":      member.GroupIPAddress,
+		"member_type":           member.MemberType,
+	}
+
+	var data struct {
+		Results AwsTgwMulticastGroupMember
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, awsTgwMulticastCheck)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Results.NetworkInterfaceIDs) == 0 {
+		return nil, ErrNotFound
+	}
+	return &data.Results, nil
+}
+
+func (c *Client) DeregisterTgwMulticastGroupMembers(ctx context.Context, member *AwsTgwMulticastGroupMember) error {
+	member.Action = "deregister_tgw_multicast_group_members"
+	member.CID = c.CID
+	member.NetworkInterfaceIDsText = strings.Join(member.NetworkInterfaceIDs, ",")
+	return c.PostAPIContext(ctx, member.Action, member, BasicCheck)
+}
@@ -52,6 +52,8 @@ type Client struct {
 	ControllerIP     string
 	baseURL          string
 	IgnoreTagsConfig *IgnoreTagsConfig
+	// EnforceAccountAudit fails plans of gateways whose access account failed its audit
+	EnforceAccountAudit bool
 }
 
 type GetApiTokenResp struct {
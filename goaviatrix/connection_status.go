@@ -0,0 +1,117 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ConnectionTunnelStatus struct {
+	GwName        string `json:"gw_name"`
+	PeerIP        string `json:"peer_ip"`
+	Status        string `json:"status"`
+	IkeSaStatus   string `json:"ike_sa_status"`
+	IpsecSaStatus string `json:"ipsec_sa_status"`
+	BgpStatus     string `json:"bgp_status"`
+	Uptime        string `json:"uptime"`
+	BytesIn       int    `json:"bytes_in"`
+	BytesOut      int    `json:"bytes_out"`
+	LastFlapTime  string `json:"last_flap_time"`
+}
+
+type ConnectionStatus struct {
+	ConnectionName string                   `json:"conn_name"`
+	ConnectionType string                   `json:"type"`
+	Status         string                   `json:"status"`
+	Tunnels        []ConnectionTunnelStatus `json:"tunnels"`
+}
+
+type ConnectionStatusResp struct {
+	Return  bool             `json:"return"`
+	Results ConnectionStatus `json:"results"`
+	Reason  string           `json:"reason"`
+}
+
+// AllTunnelsUp returns true if the connection has at least one tunnel and every tunnel is up
+func (s *ConnectionStatus) AllTunnelsUp() bool {
+	if len(s.Tunnels) == 0 {
+		return false
+	}
+	for _, tunnel := range s.Tunnels {
+		if !strings.EqualFold(tunnel.Status, "up") {
+			return false
+		}
+	}
+	return true
+}
+
+func connectionStatusCheck(action, method, reason string, ret bool) error {
+	if !ret {
+		if strings.Contains(reason, "does not exist") || strings.Contains(reason, "not found") {
+			return ErrNotFound
+		}
+		return fmt.Errorf("rest API %s %s failed: %s", action, method, reason)
+	}
+	return nil
+}
+
+// GetSite2CloudConnStatus returns the live tunnel status of a site2cloud or external device connection
+func (c *Client) GetSite2CloudConnStatus(ctx context.Context, vpcID, connName string) (*ConnectionStatus, error) {
+	form := map[string]string{
+		"CID":       c.CID,
+		"action":    "get_site2cloud_conn_status",
+		"vpc_id":    vpcID,
+		"conn_name": connName,
+	}
+
+	var data ConnectionStatusResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, connectionStatusCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Results, nil
+}
+
+// GetTransitGatewayPeeringStatus returns the live tunnel status of a transit gateway peering
+func (c *Client) GetTransitGatewayPeeringStatus(ctx context.Context, gwName1, gwName2 string) (*ConnectionStatus, error) {
+	form := map[string]string{
+		"CID":      c.CID,
+		"action":   "get_inter_transit_gateway_peering_status",
+		"gateway1": gwName1,
+		"gateway2": gwName2,
+	}
+
+	var data ConnectionStatusResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, connectionStatusCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Results, nil
+}
+
+// WaitForSite2CloudTunnelsUp polls the status of a site2cloud or external device connection
+// until all of its tunnels are up or the timeout is reached
+func (c *Client) WaitForSite2CloudTunnelsUp(ctx context.Context, vpcID, connName string, timeout time.Duration) error {
+	const sleepDuration = 10 * time.Second
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := c.GetSite2CloudConnStatus(ctx, vpcID, connName)
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+		if err == nil && status.AllTunnelsUp() {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("waited %s but the tunnels of connection %q never came up", timeout, connName)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sleepDuration):
+		}
+	}
+}
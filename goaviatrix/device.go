@@ -1,8 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -22,6 +24,9 @@ type Device struct {
 	ConnectionName     string `form:"-" json:"conn_name"`
 	SoftwareVersion    string `form:"-" json:"software_version"`
 	IsCaag             bool   `form:"-" json:"is_caag"`
+	RegistrationState  string `form:"-" json:"registration_state"`
+	Status             string `form:"-" json:"status"`
+	UpgradeStatus      string `form:"-" json:"upgrade_status"`
 }
 
 type DeviceWanInterface struct {
@@ -142,3 +147,126 @@ func (c *Client) ConfigureDeviceInterfaces(config *DeviceInterfaceConfig) error
 	}
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// ListDevices returns all CloudN and CloudWAN devices registered with the controller.
+func (c *Client) ListDevices(ctx context.Context) ([]Device, error) {
+	type Resp struct {
+		Return  bool     `json:"return"`
+		Results []Device `json:"results"`
+		Reason  string   `json:"reason"`
+	}
+	var data Resp
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_cloudwan_devices_summary",
+	}
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Results, nil
+}
+
+// UpgradeCloudn starts a software upgrade of a CloudN device. If softwareVersion is empty, the device is
+// upgraded to the controller's version.
+func (c *Client) UpgradeCloudn(ctx context.Context, deviceName, softwareVersion string) error {
+	form := map[string]string{
+		"CID":              c.CID,
+		"action":           "upgrade_cloudwan_device",
+		"device_name":      deviceName,
+		"software_version": softwareVersion,
+	}
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+func cloudnUpgradeInProgress(device *Device) bool {
+	status := strings.ToLower(device.UpgradeStatus)
+	return status == "in progress" || status == "pending"
+}
+
+// cloudnUpgradeDone reports whether the upgrade of a device to softwareVersion has finished. before is the
+// device as it was before the upgrade was started and started is set once the upgrade was seen in progress,
+// so that the status of a previous upgrade is not taken as the result of this one. It returns an error if
+// the upgrade failed.
+func cloudnUpgradeDone(before, device *Device, softwareVersion string, started bool) (bool, error) {
+	if cloudnUpgradeInProgress(device) {
+		return false, nil
+	}
+	if strings.EqualFold(device.UpgradeStatus, "failed") {
+		if !started && strings.EqualFold(before.UpgradeStatus, "failed") && device.SoftwareVersion == before.SoftwareVersion {
+			return false, nil
+		}
+		return false, fmt.Errorf("upgrade of %s failed: %s", device.Name, device.CheckReason)
+	}
+
+	if softwareVersion == "" {
+		return started || device.SoftwareVersion != before.SoftwareVersion, nil
+	}
+
+	return CloudnOnSoftwareVersion(device, softwareVersion)
+}
+
+// CloudnOnSoftwareVersion reports whether the device runs softwareVersion. Only the major and minor version are
+// compared, unless softwareVersion includes a build number.
+func CloudnOnSoftwareVersion(device *Device, softwareVersion string) (bool, error) {
+	_, current, err := ParseVersion(device.SoftwareVersion)
+	if err != nil {
+		return false, fmt.Errorf("could not parse software version %q of %s: %v", device.SoftwareVersion, device.Name, err)
+	}
+	_, target, err := ParseVersion(softwareVersion)
+	if err != nil {
+		return false, fmt.Errorf("could not parse target software version %q: %v", softwareVersion, err)
+	}
+	if current.Major != target.Major || current.Minor != target.Minor {
+		return false, nil
+	}
+	if target.HasBuild && current.Build != target.Build {
+		return false, nil
+	}
+	return true, nil
+}
+
+// WaitForCloudnUpgrade polls the device until its upgrade to softwareVersion has finished. before is the
+// device as it was before the upgrade was started.
+func (c *Client) WaitForCloudnUpgrade(ctx context.Context, before *Device, softwareVersion string) (*Device, error) {
+	const maxPoll = 180
+	sleepDuration := time.Second * 10
+	started := false
+	for i := 0; i < maxPoll; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(sleepDuration):
+		}
+
+		devices, err := c.ListDevices(ctx)
+		if err != nil {
+			// Could be transient HTTP error while the device is restarting
+			log.Infof("Could not list devices while waiting for upgrade of %s: %v", before.Name, err)
+			continue
+		}
+
+		var device *Device
+		for j := range devices {
+			if devices[j].Name == before.Name {
+				device = &devices[j]
+				break
+			}
+		}
+		if device == nil {
+			return nil, ErrNotFound
+		}
+
+		done, err := cloudnUpgradeDone(before, device, softwareVersion, started)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return device, nil
+		}
+		started = started || cloudnUpgradeInProgress(device)
+	}
+
+	return nil, fmt.Errorf("waited %s but upgrade of %s never finished. Please manually verify the upgrade status", maxPoll*sleepDuration, before.Name)
+}
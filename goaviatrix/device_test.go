@@ -0,0 +1,132 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCloudnUpgradeDone(t *testing.T) {
+	before := Device{Name: "cloudn", SoftwareVersion: "6.9.100", UpgradeStatus: "complete"}
+
+	tt := []struct {
+		Name            string
+		Before          Device
+		Device          Device
+		SoftwareVersion string
+		Started         bool
+		ExpectedDone    bool
+		ExpectedErr     string
+	}{
+		{
+			Name:         "in progress",
+			Before:       before,
+			Device:       Device{SoftwareVersion: "6.9.100", UpgradeStatus: "in progress"},
+			ExpectedDone: false,
+		},
+		{
+			Name:        "failed",
+			Before:      before,
+			Device:      Device{Name: "cloudn", SoftwareVersion: "6.9.100", UpgradeStatus: "failed", CheckReason: "disk full"},
+			Started:     true,
+			ExpectedErr: "upgrade of cloudn failed: disk full",
+		},
+		{
+			Name:         "failed before upgrade started",
+			Before:       Device{Name: "cloudn", SoftwareVersion: "6.9.100", UpgradeStatus: "failed"},
+			Device:       Device{Name: "cloudn", SoftwareVersion: "6.9.100", UpgradeStatus: "failed"},
+			ExpectedDone: false,
+		},
+		{
+			Name:         "latest not started",
+			Before:       before,
+			Device:       Device{SoftwareVersion: "6.9.100", UpgradeStatus: "complete"},
+			ExpectedDone: false,
+		},
+		{
+			Name:         "latest version changed",
+			Before:       before,
+			Device:       Device{SoftwareVersion: "7.0.1373", UpgradeStatus: "complete"},
+			ExpectedDone: true,
+		},
+		{
+			Name:         "latest after in progress",
+			Before:       before,
+			Device:       Device{SoftwareVersion: "6.9.100", UpgradeStatus: "complete"},
+			Started:      true,
+			ExpectedDone: true,
+		},
+		{
+			Name:            "release matches",
+			Before:          before,
+			Device:          Device{SoftwareVersion: "7.0.1373"},
+			SoftwareVersion: "7.0",
+			ExpectedDone:    true,
+		},
+		{
+			Name:            "build matches",
+			Before:          before,
+			Device:          Device{SoftwareVersion: "7.0.1373"},
+			SoftwareVersion: "7.0.1373",
+			ExpectedDone:    true,
+		},
+		{
+			Name:            "old release",
+			Before:          before,
+			Device:          Device{SoftwareVersion: "6.9.100"},
+			SoftwareVersion: "7.0",
+			ExpectedDone:    false,
+		},
+		{
+			Name:            "old build",
+			Before:          before,
+			Device:          Device{SoftwareVersion: "7.0.1000"},
+			SoftwareVersion: "7.0.1373",
+			ExpectedDone:    false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			done, err := cloudnUpgradeDone(&test.Before, &test.Device, test.SoftwareVersion, test.Started)
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if done != test.ExpectedDone {
+				t.Fatalf("expected done to be %t, got %t", test.ExpectedDone, done)
+			}
+		})
+	}
+}
+
+func TestCloudnOnSoftwareVersion(t *testing.T) {
+	tt := []struct {
+		Name            string
+		SoftwareVersion string
+		Target          string
+		Expected        bool
+	}{
+		{"same release", "7.0.1373", "7.0", true},
+		{"same build", "7.0.1373", "7.0.1373", true},
+		{"controller version", "7.0.1373", "UserConnect-7.0.1373", true},
+		{"other build", "7.0.1000", "7.0.1373", false},
+		{"other release", "6.9.100", "7.0", false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			onVersion, err := CloudnOnSoftwareVersion(&Device{Name: "cloudn", SoftwareVersion: test.SoftwareVersion}, test.Target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if onVersion != test.Expected {
+				t.Fatalf("expected %t, got %t", test.Expected, onVersion)
+			}
+		})
+	}
+}
@@ -3,8 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 )
 
@@ -28,6 +26,7 @@ type EdgeCaag struct {
 	Hpe                         bool   `form:"hpe,omitempty"`
 	ZtpFileType                 string `form:"ztp_file_type,omitempty"`
 	ZtpFileDownloadPath         string
+	ZtpFileContent              []byte `form:"-"`
 	State                       string `json:"state"`
 }
 
@@ -47,24 +46,8 @@ func (c *Client) CreateEdgeCaag(ctx context.Context, edgeCaag *EdgeCaag) error {
 		return err
 	}
 
-	var fileName string
-	if edgeCaag.ZtpFileType == "iso" {
-		fileName = edgeCaag.ZtpFileDownloadPath + "/" + edgeCaag.Name + ".iso"
-	} else {
-		fileName = edgeCaag.ZtpFileDownloadPath + "/" + edgeCaag.Name + "-cloud-init.txt"
-	}
-
-	outFile, err := os.Create(fileName)
-	if err != nil {
-		return err
-	}
-
-	_, err = io.Copy(outFile, resp)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	edgeCaag.ZtpFileContent, err = readEdgeZtpFile(resp, edgeCaag.ZtpFileDownloadPath, EdgeZtpFileName(edgeCaag.Name, edgeCaag.ZtpFileType))
+	return err
 }
 
 func (c *Client) GetEdgeCaag(ctx context.Context, name string) (*EdgeCaag, error) {
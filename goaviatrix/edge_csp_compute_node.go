@@ -0,0 +1,123 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+)
+
+type EdgeCSPComputeNode struct {
+	Action        string                         `json:"action,omitempty"`
+	CID           string                         `json:"CID,omitempty"`
+	AccountName   string                         `json:"account_name"`
+	ProjectUuid   string                         `json:"project_uuid"`
+	Name          string                         `json:"name"`
+	Description   string                         `json:"description"`
+	SerialNumber  string                         `json:"serial_number"`
+	Model         string                         `json:"model"`
+	OnboardingKey string                         `json:"onboarding_key,omitempty"`
+	Interfaces    []*EdgeCSPComputeNodeInterface `json:"interfaces"`
+	Uuid          string                         `json:"uuid,omitempty"`
+	AdminState    string                         `json:"admin_state,omitempty"`
+	RunState      string                         `json:"run_state,omitempty"`
+}
+
+// EdgeCSPComputeNodeInterface maps a physical port of the compute node model to a logical interface of the Edge gateway.
+type EdgeCSPComputeNodeInterface struct {
+	LogicalName  string `json:"logical_name"`
+	PhysicalName string `json:"physical_name"`
+	Type         string `json:"type"`
+}
+
+// ValidateEdgeCSPComputeNodeInterfaces checks that every logical and physical interface is mapped at most once
+// and that there is at least one WAN and one LAN interface.
+func ValidateEdgeCSPComputeNodeInterfaces(interfaces []*EdgeCSPComputeNodeInterface) error {
+	logicalNames := make(map[string]bool)
+	physicalNames := make(map[string]bool)
+	typeCount := make(map[string]int)
+
+	for _, edgeInterface := range interfaces {
+		if logicalNames[edgeInterface.LogicalName] {
+			return fmt.Errorf("logical interface %q is mapped more than once", edgeInterface.LogicalName)
+		}
+		logicalNames[edgeInterface.LogicalName] = true
+
+		if physicalNames[edgeInterface.PhysicalName] {
+			return fmt.Errorf("physical interface %q is mapped more than once", edgeInterface.PhysicalName)
+		}
+		physicalNames[edgeInterface.PhysicalName] = true
+
+		typeCount[edgeInterface.Type]++
+	}
+
+	if typeCount["WAN"] == 0 || typeCount["LAN"] == 0 {
+		return fmt.Errorf("at least one WAN and one LAN interface are required")
+	}
+	if typeCount["MGMT"] > 1 {
+		return fmt.Errorf("at most one MGMT interface is allowed")
+	}
+	return nil
+}
+
+func (c *Client) CreateEdgeCSPComputeNode(ctx context.Context, edgeCSPComputeNode *EdgeCSPComputeNode) (string, error) {
+	edgeCSPComputeNode.Action = "create_edge_csp_compute_node"
+	edgeCSPComputeNode.CID = c.CID
+
+	type EdgeCSPComputeNodeResp struct {
+		Results EdgeCSPComputeNode `json:"results"`
+	}
+
+	var resp EdgeCSPComputeNodeResp
+	err := c.PostAPIContext2(ctx, &resp, edgeCSPComputeNode.Action, edgeCSPComputeNode, BasicCheck)
+	if err != nil {
+		return "", err
+	}
+	if resp.Results.Uuid == "" {
+		return "", fmt.Errorf("controller did not return the UUID of the Edge CSP compute node")
+	}
+
+	return resp.Results.Uuid, nil
+}
+
+func (c *Client) GetEdgeCSPComputeNode(ctx context.Context, accountName, uuid string) (*EdgeCSPComputeNode, error) {
+	form := map[string]string{
+		"action":       "list_edge_csp_compute_nodes",
+		"CID":          c.CID,
+		"account_name": accountName,
+	}
+
+	type EdgeCSPComputeNodeListResp struct {
+		Results []EdgeCSPComputeNode `json:"results"`
+	}
+
+	var resp EdgeCSPComputeNodeListResp
+	err := c.PostAPIContext2(ctx, &resp, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edgeCSPComputeNode := range resp.Results {
+		if edgeCSPComputeNode.Uuid == uuid {
+			edgeCSPComputeNode.AccountName = accountName
+			return &edgeCSPComputeNode, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (c *Client) UpdateEdgeCSPComputeNode(ctx context.Context, edgeCSPComputeNode *EdgeCSPComputeNode) error {
+	edgeCSPComputeNode.Action = "update_edge_csp_compute_node"
+	edgeCSPComputeNode.CID = c.CID
+	return c.PostAPIContext2(ctx, nil, edgeCSPComputeNode.Action, edgeCSPComputeNode, BasicCheck)
+}
+
+func (c *Client) DeleteEdgeCSPComputeNode(ctx context.Context, accountName, uuid string) error {
+	form := map[string]string{
+		"action":       "delete_edge_csp_compute_node",
+		"CID":          c.CID,
+		"account_name": accountName,
+		"uuid":         uuid,
+	}
+
+	return c.PostAPIContext2(ctx, nil, form["action"], form, BasicCheck)
+}
@@ -0,0 +1,58 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEdgeCSPComputeNodeInterfaces(t *testing.T) {
+	wan := &EdgeCSPComputeNodeInterface{LogicalName: "eth0", PhysicalName: "port1", Type: "WAN"}
+	lan := &EdgeCSPComputeNodeInterface{LogicalName: "eth1", PhysicalName: "port2", Type: "LAN"}
+	mgmt := &EdgeCSPComputeNodeInterface{LogicalName: "eth2", PhysicalName: "port3", Type: "MGMT"}
+
+	tt := []struct {
+		Name        string
+		Interfaces  []*EdgeCSPComputeNodeInterface
+		ExpectedErr string
+	}{
+		{
+			Name:       "valid",
+			Interfaces: []*EdgeCSPComputeNodeInterface{wan, lan, mgmt},
+		},
+		{
+			Name:        "missing wan",
+			Interfaces:  []*EdgeCSPComputeNodeInterface{lan, mgmt},
+			ExpectedErr: "at least one WAN and one LAN interface are required",
+		},
+		{
+			Name:        "duplicate logical name",
+			Interfaces:  []*EdgeCSPComputeNodeInterface{wan, lan, {LogicalName: "eth0", PhysicalName: "port4", Type: "LAN"}},
+			ExpectedErr: "logical interface \"eth0\" is mapped more than once",
+		},
+		{
+			Name:        "duplicate physical name",
+			Interfaces:  []*EdgeCSPComputeNodeInterface{wan, lan, {LogicalName: "eth3", PhysicalName: "port1", Type: "LAN"}},
+			ExpectedErr: "physical interface \"port1\" is mapped more than once",
+		},
+		{
+			Name:        "two mgmt",
+			Interfaces:  []*EdgeCSPComputeNodeInterface{wan, lan, mgmt, {LogicalName: "eth3", PhysicalName: "port4", Type: "MGMT"}},
+			ExpectedErr: "at most one MGMT interface",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateEdgeCSPComputeNodeInterfaces(test.Interfaces)
+			if test.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+				t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+)
+
+type EdgeCSPProject struct {
+	Action      string `json:"action,omitempty"`
+	CID         string `json:"CID,omitempty"`
+	AccountName string `json:"account_name"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Uuid        string `json:"uuid,omitempty"`
+}
+
+func (c *Client) CreateEdgeCSPProject(ctx context.Context, edgeCSPProject *EdgeCSPProject) (string, error) {
+	edgeCSPProject.Action = "create_edge_csp_project"
+	edgeCSPProject.CID = c.CID
+
+	type EdgeCSPProjectResp struct {
+		Results EdgeCSPProject `json:"results"`
+	}
+
+	var resp EdgeCSPProjectResp
+	err := c.PostAPIContext2(ctx, &resp, edgeCSPProject.Action, edgeCSPProject, BasicCheck)
+	if err != nil {
+		return "", err
+	}
+	if resp.Results.Uuid == "" {
+		return "", fmt.Errorf("controller did not return the UUID of the Edge CSP project")
+	}
+
+	return resp.Results.Uuid, nil
+}
+
+func (c *Client) GetEdgeCSPProject(ctx context.Context, accountName, uuid string) (*EdgeCSPProject, error) {
+	form := map[string]string{
+		"action":       "list_edge_csp_projects",
+		"CID":          c.CID,
+		"account_name": accountName,
+	}
+
+	type EdgeCSPProjectListResp struct {
+		Results []EdgeCSPProject `json:"results"`
+	}
+
+	var resp EdgeCSPProjectListResp
+	err := c.PostAPIContext2(ctx, &resp, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edgeCSPProject := range resp.Results {
+		if edgeCSPProject.Uuid == uuid {
+			edgeCSPProject.AccountName = accountName
+			return &edgeCSPProject, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (c *Client) UpdateEdgeCSPProject(ctx context.Context, edgeCSPProject *EdgeCSPProject) error {
+	edgeCSPProject.Action = "update_edge_csp_project"
+	edgeCSPProject.CID = c.CID
+	return c.PostAPIContext2(ctx, nil, edgeCSPProject.Action, edgeCSPProject, BasicCheck)
+}
+
+func (c *Client) DeleteEdgeCSPProject(ctx context.Context, accountName, uuid string) error {
+	form := map[string]string{
+		"action":       "delete_edge_csp_project",
+		"CID":          c.CID,
+		"account_name": accountName,
+		"uuid":         uuid,
+	}
+
+	return c.PostAPIContext2(ctx, nil, form["action"], form, BasicCheck)
+}
@@ -2,10 +2,9 @@ package goaviatrix
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"io"
 	"math"
-	"os"
 	"strconv"
 	"strings"
 
@@ -32,6 +31,7 @@ type EdgeSpoke struct {
 	Dhcp                               bool   `form:"dhcp,omitempty" json:"dhcp"`
 	ZtpFileType                        string `form:"ztp_file_type,omitempty"`
 	ZtpFileDownloadPath                string
+	ZtpFileContent                     []byte `form:"-"`
 	ActiveStandby                      string `form:"active_standby,omitempty"`
 	EnableEdgeActiveStandby            bool   `json:"edge_active_standby"`
 	EnableEdgeActiveStandbyPreemptive  bool   `json:"edge_active_standby_preemptive"`
@@ -49,12 +49,38 @@ type EdgeSpoke struct {
 	EnableJumboFrame                   bool     `json:"jumbo_frame"`
 	Latitude                           string
 	Longitude                          string
-	LatitudeReturn                     float64 `json:"latitude"`
-	LongitudeReturn                    float64 `json:"longitude"`
-	WanPublicIp                        string  `form:"wan_discovery_ip,omitempty" json:"public_ip"`
-	PrivateIP                          string  `json:"private_ip"`
-	RxQueueSize                        string  `json:"rx_queue_size"`
-	State                              string  `json:"vpc_state"`
+	LatitudeReturn                     float64               `json:"latitude"`
+	LongitudeReturn                    float64               `json:"longitude"`
+	WanPublicIp                        string                `form:"wan_discovery_ip,omitempty" json:"public_ip"`
+	PrivateIP                          string                `json:"private_ip"`
+	RxQueueSize                        string                `json:"rx_queue_size"`
+	State                              string                `json:"vpc_state"`
+	PrimaryGwName                      string                `form:"-" json:"primary_gw_name"`
+	CloudType                          int                   `form:"-" json:"cloud_type"`
+	SoftwareVersion                    string                `form:"-" json:"gw_software_version"`
+	TransitGwName                      string                `form:"-" json:"transit_gw_name"`
+	InterfaceList                      []*EdgeSpokeInterface `form:"-" json:"interfaces"`
+	Interfaces                         string                `form:"interfaces,omitempty" json:"-"`
+	VlanList                           []*EdgeSpokeVlan      `form:"-" json:"vlan"`
+	Vlan                               string                `form:"vlan,omitempty" json:"-"`
+}
+
+type EdgeSpokeInterface struct {
+	IfName    string `json:"ifname"`
+	Type      string `json:"type"`
+	Dhcp      bool   `json:"dhcp"`
+	IpAddr    string `json:"ipaddr,omitempty"`
+	GatewayIp string `json:"gateway_ip,omitempty"`
+	PublicIp  string `json:"public_ip,omitempty"`
+	Tag       string `json:"tag,omitempty"`
+}
+
+type EdgeSpokeVlan struct {
+	ParentInterface string `json:"parent_interface"`
+	VlanId          int    `json:"vlan_id"`
+	IpAddr          string `json:"ipaddr"`
+	GatewayIp       string `json:"gateway_ip,omitempty"`
+	Tag             string `json:"tag,omitempty"`
 }
 
 type EdgeSpokeListResp struct {
@@ -73,29 +99,24 @@ func (c *Client) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) erro
 		edgeSpoke.Dhcp = true
 	}
 
-	resp, err := c.PostAPIDownloadContext(ctx, edgeSpoke.Action, edgeSpoke, BasicCheck)
-	if err != nil {
-		return err
-	}
-
-	var fileName string
-	if edgeSpoke.ZtpFileType == "iso" {
-		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + ".iso"
-	} else {
-		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + "-cloud-init.txt"
-	}
-
-	outFile, err := os.Create(fileName)
-	if err != nil {
-		return err
+	if len(edgeSpoke.InterfaceList) != 0 {
+		for _, edgeInterface := range edgeSpoke.InterfaceList {
+			if edgeInterface.Type == "MGMT" {
+				edgeSpoke.Dhcp = edgeInterface.Dhcp
+			}
+		}
+		if err := edgeSpoke.marshalInterfaces(); err != nil {
+			return err
+		}
 	}
 
-	_, err = io.Copy(outFile, resp)
+	resp, err := c.PostAPIDownloadContext(ctx, edgeSpoke.Action, edgeSpoke, BasicCheck)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	edgeSpoke.ZtpFileContent, err = readEdgeZtpFile(resp, edgeSpoke.ZtpFileDownloadPath, EdgeZtpFileName(edgeSpoke.GwName+"-"+edgeSpoke.SiteId, edgeSpoke.ZtpFileType))
+	return err
 }
 
 func (c *Client) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpoke, error) {
@@ -128,21 +149,161 @@ func (c *Client) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpoke, e
 	return nil, ErrNotFound
 }
 
+// ListEdgeGateways returns all Edge as a Spoke, Edge as a CaaG and Edge CSP gateways.
+func (c *Client) ListEdgeGateways(ctx context.Context) ([]EdgeSpoke, error) {
+	form := map[string]string{
+		"action":    "list_vpcs_summary",
+		"CID":       c.CID,
+		"edge_only": "true",
+	}
+
+	var data EdgeSpokeListResp
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	// Older controllers ignore edge_only and return all gateways, so drop the ones in a public cloud
+	var edgeGateways []EdgeSpoke
+	for _, gw := range data.Results {
+		if gw.IsEdgeGateway() {
+			edgeGateways = append(edgeGateways, gw)
+		}
+	}
+	return edgeGateways, nil
+}
+
+// publicCloudTypes are the cloud types of gateways that are not Edge gateways
+const publicCloudTypes = AWSRelatedCloudTypes | GCPRelatedCloudTypes | AzureArmRelatedCloudTypes |
+	OCIRelatedCloudTypes | AliCloudRelatedCloudTypes
+
+// IsEdgeGateway returns false for gateways in a public cloud. The cloud type of Edge as a Spoke gateways is
+// not known, so all other gateways are treated as Edge gateways.
+func (edgeSpoke *EdgeSpoke) IsEdgeGateway() bool {
+	return edgeSpoke.Caag || !IsCloudType(edgeSpoke.CloudType, publicCloudTypes)
+}
+
+// EdgeGatewayType returns "csp" for Edge CSP gateways, "caag" for Edge as a CaaG and "spoke" for Edge as a Spoke.
+func (edgeSpoke *EdgeSpoke) EdgeGatewayType() string {
+	if edgeSpoke.CloudType == EDGECSP {
+		return "csp"
+	}
+	if edgeSpoke.Caag {
+		return "caag"
+	}
+	return "spoke"
+}
+
+// TransitGwNames returns the names of the transit gateways the Edge gateway is attached to.
+func (edgeSpoke *EdgeSpoke) TransitGwNames() []string {
+	var transitGwNames []string
+	for _, name := range strings.Split(edgeSpoke.TransitGwName, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			transitGwNames = append(transitGwNames, name)
+		}
+	}
+	return transitGwNames
+}
+
+func (edgeSpoke *EdgeSpoke) marshalInterfaces() error {
+	interfaces, err := json.Marshal(edgeSpoke.InterfaceList)
+	if err != nil {
+		return fmt.Errorf("could not marshal interfaces: %v", err)
+	}
+	edgeSpoke.Interfaces = string(interfaces)
+
+	vlanList := edgeSpoke.VlanList
+	if vlanList == nil {
+		vlanList = []*EdgeSpokeVlan{}
+	}
+	vlan, err := json.Marshal(vlanList)
+	if err != nil {
+		return fmt.Errorf("could not marshal vlan: %v", err)
+	}
+	edgeSpoke.Vlan = string(vlan)
+	return nil
+}
+
+// UpdateEdgeSpokeIpConfigurations updates the interfaces of an Edge as a Spoke. If InterfaceList is set,
+// the controller adds, removes and updates interfaces and VLAN sub-interfaces in place to match it.
+// Otherwise the single WAN and LAN interface are updated.
 func (c *Client) UpdateEdgeSpokeIpConfigurations(ctx context.Context, edgeSpoke *EdgeSpoke) error {
 	form := map[string]string{
-		"action":              "update_edge_gateway",
-		"CID":                 c.CID,
-		"gateway_name":        edgeSpoke.GwName,
-		"wan_ip":              edgeSpoke.WanInterfaceIpPrefix,
-		"wan_default_gateway": edgeSpoke.WanDefaultGatewayIp,
-		"lan_ip":              edgeSpoke.LanInterfaceIpPrefix,
-		"mgmt_egress_ip":      edgeSpoke.ManagementEgressIpPrefix,
-		"wan_discovery_ip":    edgeSpoke.WanPublicIp,
+		"action":         "update_edge_gateway",
+		"CID":            c.CID,
+		"gateway_name":   edgeSpoke.GwName,
+		"mgmt_egress_ip": edgeSpoke.ManagementEgressIpPrefix,
+	}
+
+	if len(edgeSpoke.InterfaceList) != 0 {
+		if err := edgeSpoke.marshalInterfaces(); err != nil {
+			return err
+		}
+		form["interfaces"] = edgeSpoke.Interfaces
+		form["vlan"] = edgeSpoke.Vlan
+	} else {
+		form["wan_ip"] = edgeSpoke.WanInterfaceIpPrefix
+		form["wan_default_gateway"] = edgeSpoke.WanDefaultGatewayIp
+		form["lan_ip"] = edgeSpoke.LanInterfaceIpPrefix
+		form["wan_discovery_ip"] = edgeSpoke.WanPublicIp
 	}
 
 	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
+// ValidateEdgeSpokeInterfaces checks that an Edge as a Spoke has at least one WAN and one LAN interface,
+// at most one MGMT interface, and that the addressing of each interface and VLAN is consistent.
+func ValidateEdgeSpokeInterfaces(interfaces []*EdgeSpokeInterface, vlans []*EdgeSpokeVlan) error {
+	names := make(map[string]string)
+	count := make(map[string]int)
+	for _, edgeInterface := range interfaces {
+		if _, ok := names[edgeInterface.IfName]; ok {
+			return fmt.Errorf("interface %q is defined more than once", edgeInterface.IfName)
+		}
+		names[edgeInterface.IfName] = edgeInterface.Type
+		count[edgeInterface.Type]++
+
+		if edgeInterface.Dhcp {
+			if edgeInterface.IpAddr != "" || edgeInterface.GatewayIp != "" {
+				return fmt.Errorf("'ip_address' and 'gateway_ip' must be empty for interface %q when 'enable_dhcp' is true", edgeInterface.IfName)
+			}
+			if edgeInterface.Type == "LAN" {
+				return fmt.Errorf("DHCP is not supported for LAN interface %q", edgeInterface.IfName)
+			}
+			continue
+		}
+		if edgeInterface.IpAddr == "" {
+			return fmt.Errorf("'ip_address' is required for interface %q when 'enable_dhcp' is false", edgeInterface.IfName)
+		}
+		if edgeInterface.Type != "LAN" && edgeInterface.GatewayIp == "" {
+			return fmt.Errorf("'gateway_ip' is required for %s interface %q when 'enable_dhcp' is false", edgeInterface.Type, edgeInterface.IfName)
+		}
+		if edgeInterface.PublicIp != "" && edgeInterface.Type != "WAN" {
+			return fmt.Errorf("'wan_public_ip' is only valid for WAN interfaces, interface %q is %s", edgeInterface.IfName, edgeInterface.Type)
+		}
+	}
+	if count["WAN"] == 0 || count["LAN"] == 0 {
+		return fmt.Errorf("at least one WAN and one LAN interface are required")
+	}
+	if count["MGMT"] > 1 {
+		return fmt.Errorf("at most one MGMT interface is allowed")
+	}
+
+	vlanIds := make(map[string]bool)
+	for _, vlan := range vlans {
+		if names[vlan.ParentInterface] != "LAN" {
+			return fmt.Errorf("parent interface %q of VLAN %d must be a LAN interface", vlan.ParentInterface, vlan.VlanId)
+		}
+		key := fmt.Sprintf("%s.%d", vlan.ParentInterface, vlan.VlanId)
+		if vlanIds[key] {
+			return fmt.Errorf("VLAN %d is defined more than once on interface %q", vlan.VlanId, vlan.ParentInterface)
+		}
+		vlanIds[key] = true
+	}
+	return nil
+}
+
 func (c *Client) DeleteEdgeSpoke(ctx context.Context, name string) error {
 	form := map[string]string{
 		"action": "delete_edge_gateway",
@@ -0,0 +1,62 @@
+package goaviatrix
+
+import (
+	"context"
+)
+
+type EdgeSpokeHa struct {
+	Action                             string                `form:"action,omitempty"`
+	CID                                string                `form:"CID,omitempty"`
+	PrimaryGwName                      string                `form:"primary_gw_name,omitempty"`
+	ManagementEgressIpPrefix           string                `form:"mgmt_egress_ip,omitempty"`
+	EnableManagementOverPrivateNetwork bool                  `form:"mgmt_over_private_network,omitempty"`
+	DnsServerIp                        string                `form:"dns_server_ip,omitempty"`
+	SecondaryDnsServerIp               string                `form:"dns_server_ip_secondary,omitempty"`
+	Dhcp                               bool                  `form:"dhcp,omitempty"`
+	InterfaceList                      []*EdgeSpokeInterface `form:"-"`
+	Interfaces                         string                `form:"interfaces,omitempty"`
+	VlanList                           []*EdgeSpokeVlan      `form:"-"`
+	Vlan                               string                `form:"vlan,omitempty"`
+	ZtpFileType                        string                `form:"ztp_file_type,omitempty"`
+	ZtpFileDownloadPath                string                `form:"-"`
+	ZtpFileContent                     []byte                `form:"-"`
+}
+
+// EdgeSpokeHaGwName returns the name the controller gives the HA gateway of an Edge as a Spoke.
+func EdgeSpokeHaGwName(primaryGwName string) string {
+	return primaryGwName + "-hagw"
+}
+
+// CreateEdgeSpokeHa creates the peer Edge as a Spoke of an HA pair and returns the name of the HA gateway.
+func (c *Client) CreateEdgeSpokeHa(ctx context.Context, edgeSpokeHa *EdgeSpokeHa) (string, error) {
+	edgeSpokeHa.Action = "create_multicloud_ha_gateway"
+	edgeSpokeHa.CID = c.CID
+
+	edgeSpoke := &EdgeSpoke{
+		InterfaceList: edgeSpokeHa.InterfaceList,
+		VlanList:      edgeSpokeHa.VlanList,
+	}
+	if err := edgeSpoke.marshalInterfaces(); err != nil {
+		return "", err
+	}
+	edgeSpokeHa.Interfaces = edgeSpoke.Interfaces
+	edgeSpokeHa.Vlan = edgeSpoke.Vlan
+
+	for _, edgeInterface := range edgeSpokeHa.InterfaceList {
+		if edgeInterface.Type == "MGMT" {
+			edgeSpokeHa.Dhcp = edgeInterface.Dhcp
+		}
+	}
+
+	resp, err := c.PostAPIDownloadContext(ctx, edgeSpokeHa.Action, edgeSpokeHa, BasicCheck)
+	if err != nil {
+		return "", err
+	}
+
+	haGwName := EdgeSpokeHaGwName(edgeSpokeHa.PrimaryGwName)
+	edgeSpokeHa.ZtpFileContent, err = readEdgeZtpFile(resp, edgeSpokeHa.ZtpFileDownloadPath, EdgeZtpFileName(haGwName, edgeSpokeHa.ZtpFileType))
+	if err != nil {
+		return "", err
+	}
+	return haGwName, nil
+}
@@ -0,0 +1,158 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEdgeSpokeInterfaces(t *testing.T) {
+	wan := &EdgeSpokeInterface{IfName: "eth0", Type: "WAN", IpAddr: "10.0.0.2/24", GatewayIp: "10.0.0.1"}
+	mpls := &EdgeSpokeInterface{IfName: "eth3", Type: "WAN", Dhcp: true}
+	lan := &EdgeSpokeInterface{IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.2/24"}
+	mgmt := &EdgeSpokeInterface{IfName: "eth2", Type: "MGMT", Dhcp: true}
+
+	tt := []struct {
+		Name        string
+		Interfaces  []*EdgeSpokeInterface
+		Vlans       []*EdgeSpokeVlan
+		ExpectedErr string
+	}{
+		{
+			Name:       "dual wan with vlans",
+			Interfaces: []*EdgeSpokeInterface{wan, mpls, lan, mgmt},
+			Vlans: []*EdgeSpokeVlan{
+				{ParentInterface: "eth1", VlanId: 10, IpAddr: "10.10.0.1/24"},
+				{ParentInterface: "eth1", VlanId: 20, IpAddr: "10.20.0.1/24"},
+			},
+		},
+		{
+			Name:        "missing lan",
+			Interfaces:  []*EdgeSpokeInterface{wan, mgmt},
+			ExpectedErr: "at least one WAN and one LAN interface are required",
+		},
+		{
+			Name:        "duplicate name",
+			Interfaces:  []*EdgeSpokeInterface{wan, lan, {IfName: "eth0", Type: "MGMT", Dhcp: true}},
+			ExpectedErr: "defined more than once",
+		},
+		{
+			Name:        "two mgmt",
+			Interfaces:  []*EdgeSpokeInterface{wan, lan, mgmt, {IfName: "eth4", Type: "MGMT", Dhcp: true}},
+			ExpectedErr: "at most one MGMT interface",
+		},
+		{
+			Name:        "static wan without gateway",
+			Interfaces:  []*EdgeSpokeInterface{{IfName: "eth0", Type: "WAN", IpAddr: "10.0.0.2/24"}, lan},
+			ExpectedErr: "'gateway_ip' is required",
+		},
+		{
+			Name:        "dhcp with ip",
+			Interfaces:  []*EdgeSpokeInterface{{IfName: "eth0", Type: "WAN", Dhcp: true, IpAddr: "10.0.0.2/24"}, lan},
+			ExpectedErr: "must be empty",
+		},
+		{
+			Name:        "public ip on lan",
+			Interfaces:  []*EdgeSpokeInterface{wan, {IfName: "eth1", Type: "LAN", IpAddr: "10.1.0.2/24", PublicIp: "1.2.3.4"}},
+			ExpectedErr: "only valid for WAN interfaces",
+		},
+		{
+			Name:        "vlan on wan",
+			Interfaces:  []*EdgeSpokeInterface{wan, lan},
+			Vlans:       []*EdgeSpokeVlan{{ParentInterface: "eth0", VlanId: 10, IpAddr: "10.10.0.1/24"}},
+			ExpectedErr: "must be a LAN interface",
+		},
+		{
+			Name:       "duplicate vlan",
+			Interfaces: []*EdgeSpokeInterface{wan, lan},
+			Vlans: []*EdgeSpokeVlan{
+				{ParentInterface: "eth1", VlanId: 10, IpAddr: "10.10.0.1/24"},
+				{ParentInterface: "eth1", VlanId: 10, IpAddr: "10.11.0.1/24"},
+			},
+			ExpectedErr: "VLAN 10 is defined more than once",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateEdgeSpokeInterfaces(test.Interfaces, test.Vlans)
+			if test.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+				t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+			}
+		})
+	}
+}
+
+func TestEdgeGatewayType(t *testing.T) {
+	tt := []struct {
+		Name      string
+		EdgeSpoke EdgeSpoke
+		Expected  string
+	}{
+		{"spoke", EdgeSpoke{}, "spoke"},
+		{"caag", EdgeSpoke{Caag: true}, "caag"},
+		{"csp", EdgeSpoke{CloudType: EDGECSP}, "csp"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			if got := test.EdgeSpoke.EdgeGatewayType(); got != test.Expected {
+				t.Fatalf("expected %q, got %q", test.Expected, got)
+			}
+		})
+	}
+}
+
+func TestIsEdgeGateway(t *testing.T) {
+	tt := []struct {
+		Name      string
+		EdgeSpoke EdgeSpoke
+		Expected  bool
+	}{
+		{"spoke without cloud type", EdgeSpoke{}, true},
+		{"spoke with unknown cloud type", EdgeSpoke{CloudType: 262144}, true},
+		{"caag", EdgeSpoke{Caag: true}, true},
+		{"csp", EdgeSpoke{CloudType: EDGECSP}, true},
+		{"aws", EdgeSpoke{CloudType: AWS}, false},
+		{"aws gov", EdgeSpoke{CloudType: AWSGov}, false},
+		{"gcp", EdgeSpoke{CloudType: GCP}, false},
+		{"azure", EdgeSpoke{CloudType: Azure}, false},
+		{"oci", EdgeSpoke{CloudType: OCI}, false},
+		{"alicloud", EdgeSpoke{CloudType: AliCloud}, false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			if got := test.EdgeSpoke.IsEdgeGateway(); got != test.Expected {
+				t.Fatalf("expected %t, got %t", test.Expected, got)
+			}
+		})
+	}
+}
+
+func TestEdgeSpokeTransitGwNames(t *testing.T) {
+	tt := []struct {
+		Name          string
+		TransitGwName string
+		Expected      []string
+	}{
+		{"not attached", "", nil},
+		{"single", "transit-1", []string{"transit-1"}},
+		{"multiple", "transit-1, transit-2,", []string{"transit-1", "transit-2"}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			edgeSpoke := &EdgeSpoke{TransitGwName: test.TransitGwName}
+			got := edgeSpoke.TransitGwNames()
+			if strings.Join(got, ",") != strings.Join(test.Expected, ",") || len(got) != len(test.Expected) {
+				t.Fatalf("expected %v, got %v", test.Expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// EdgeZtpFileName returns the name of the ZTP file of an Edge gateway.
+func EdgeZtpFileName(name, ztpFileType string) string {
+	if ztpFileType == "iso" {
+		return name + ".iso"
+	}
+	return name + "-cloud-init.txt"
+}
+
+// readEdgeZtpFile reads a ZTP file returned by the controller and, if downloadPath is set,
+// also writes it to downloadPath/fileName.
+func readEdgeZtpFile(resp io.ReadCloser, downloadPath, fileName string) ([]byte, error) {
+	defer resp.Close()
+
+	content, err := ioutil.ReadAll(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ZTP file: %v", err)
+	}
+
+	if downloadPath != "" {
+		if err := ioutil.WriteFile(downloadPath+"/"+fileName, content, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	return content, nil
+}
+
+// EdgeZtpFileSha256 returns the hex encoded SHA-256 checksum of a ZTP file.
+func EdgeZtpFileSha256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// GetEdgeZtpFile has the controller generate a new ZTP file for an existing Edge gateway.
+func (c *Client) GetEdgeZtpFile(ctx context.Context, gwName, ztpFileType, downloadPath, fileName string) ([]byte, error) {
+	form := map[string]string{
+		"action":        "generate_edge_ztp_file",
+		"CID":           c.CID,
+		"gateway_name":  gwName,
+		"ztp_file_type": ztpFileType,
+	}
+
+	resp, err := c.PostAPIDownloadContext(ctx, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return readEdgeZtpFile(resp, downloadPath, fileName)
+}
+
+// UploadEdgeZtpFile uploads a ZTP file with an HTTP PUT request, e.g. to a pre-signed object storage URL.
+func UploadEdgeZtpFile(ctx context.Context, url string, headers map[string]string, content []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload ZTP file: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to upload ZTP file: PUT returned status %s", resp.Status)
+	}
+	return nil
+}
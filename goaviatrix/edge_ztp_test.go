@@ -0,0 +1,36 @@
+package goaviatrix
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestReadEdgeZtpFile(t *testing.T) {
+	dir := t.TempDir()
+	fileName := EdgeZtpFileName("edge-site1", "cloud-init")
+	if fileName != "edge-site1-cloud-init.txt" {
+		t.Fatalf("unexpected file name %q", fileName)
+	}
+
+	content, err := readEdgeZtpFile(ioutil.NopCloser(strings.NewReader("#cloud-config")), dir, fileName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "#cloud-config" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	written, err := ioutil.ReadFile(dir + "/" + fileName)
+	if err != nil {
+		t.Fatalf("ZTP file was not written: %v", err)
+	}
+	if string(written) != "#cloud-config" {
+		t.Errorf("unexpected file content %q", written)
+	}
+
+	content, err = readEdgeZtpFile(ioutil.NopCloser(strings.NewReader("iso")), "", EdgeZtpFileName("edge", "iso"))
+	if err != nil || string(content) != "iso" {
+		t.Errorf("expected content without download path, got %q, %v", content, err)
+	}
+}
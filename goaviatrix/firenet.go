@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -70,6 +71,12 @@ type FirewallInstanceInfo struct {
 	VendorType          string `json:"vendor,omitempty"`
 }
 
+type FireNetFirewallHealth struct {
+	InstanceID   string `json:"instance_id"`
+	LanKeepAlive string `json:"lan_keepalive"`
+	HealthCheck  string `json:"health_check"`
+}
+
 func (c *Client) CreateFireNet(fireNet *FireNet) error {
 	return nil
 }
@@ -105,6 +112,34 @@ func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
 	return nil, ErrNotFound
 }
 
+func (c *Client) GetFireNetFirewallHealth(ctx context.Context, vpcID string) ([]FireNetFirewallHealth, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "get_firenet_firewall_health_status",
+		"vpc_id": vpcID,
+	}
+
+	var data struct {
+		Results []FireNetFirewallHealth `json:"results"`
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "not found in DB") {
+				return ErrNotFound
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
 func (c *Client) AssociateFirewallWithFireNet(firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":          c.CID,
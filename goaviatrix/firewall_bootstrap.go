@@ -0,0 +1,342 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+const (
+	FirewallVendorPaloAlto   = "Palo Alto Networks"
+	FirewallVendorFortinet   = "Fortinet FortiGate"
+	FirewallVendorCheckPoint = "Check Point"
+)
+
+// FirewallBootstrapConfig holds the inputs used to render a firewall bootstrap bundle
+type FirewallBootstrapConfig struct {
+	FirewallImage       string
+	Hostname            string
+	AdminUsername       string
+	AdminPassword       string
+	AdminPasswordHash   string
+	SshPublicKey        string
+	LanInterfaceName    string
+	EgressInterfaceName string
+	LanGatewayIP        string
+	LanRoutes           []string
+	AllowAllTraffic     bool
+	LicenseAuthCodes    []string
+	SicKey              string
+	Panorama            *PanoramaBootstrapConfig
+	FortiManager        *FortiManagerBootstrapConfig
+}
+
+type PanoramaBootstrapConfig struct {
+	Server        string
+	Server2       string
+	TemplateStack string
+	DeviceGroup   string
+	VmAuthKey     string
+}
+
+type FortiManagerBootstrapConfig struct {
+	IP           string
+	SerialNumber string
+}
+
+// FirewallBootstrapBundle is the rendered bootstrap bundle. Files are keyed by their path
+// relative to the root of the bootstrap bucket or file share.
+type FirewallBootstrapBundle struct {
+	Vendor              string
+	LanInterfaceName    string
+	EgressInterfaceName string
+	Files               map[string]string
+	UserData            string
+}
+
+func GetFirewallVendor(firewallImage string) (string, error) {
+	for _, vendor := range []string{FirewallVendorPaloAlto, FirewallVendorFortinet, FirewallVendorCheckPoint} {
+		if strings.HasPrefix(firewallImage, vendor) {
+			return vendor, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported firewall image %q: must be a Palo Alto Networks, Fortinet FortiGate or Check Point image", firewallImage)
+}
+
+func RenderFirewallBootstrap(config *FirewallBootstrapConfig) (*FirewallBootstrapBundle, error) {
+	vendor, err := GetFirewallVendor(config.FirewallImage)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &FirewallBootstrapBundle{
+		Vendor:              vendor,
+		LanInterfaceName:    config.LanInterfaceName,
+		EgressInterfaceName: config.EgressInterfaceName,
+		Files:               make(map[string]string),
+	}
+
+	var defaultEgress, defaultLan string
+	switch vendor {
+	case FirewallVendorPaloAlto:
+		defaultEgress, defaultLan = "ethernet1/1", "ethernet1/2"
+	case FirewallVendorFortinet:
+		defaultEgress, defaultLan = "port1", "port2"
+	case FirewallVendorCheckPoint:
+		defaultEgress, defaultLan = "eth0", "eth1"
+	}
+	if bundle.EgressInterfaceName == "" {
+		bundle.EgressInterfaceName = defaultEgress
+	}
+	if bundle.LanInterfaceName == "" {
+		bundle.LanInterfaceName = defaultLan
+	}
+	if bundle.EgressInterfaceName == bundle.LanInterfaceName {
+		return nil, fmt.Errorf("lan and egress interfaces must be different, got %q for both", bundle.LanInterfaceName)
+	}
+
+	if len(config.LanRoutes) != 0 && config.LanGatewayIP == "" {
+		return nil, fmt.Errorf("'lan_gateway_ip' is required when 'lan_routes' is set")
+	}
+	for _, route := range config.LanRoutes {
+		if _, _, err := net.ParseCIDR(route); err != nil {
+			return nil, fmt.Errorf("invalid LAN route %q: %v", route, err)
+		}
+	}
+	if len(config.LicenseAuthCodes) != 0 && vendor != FirewallVendorPaloAlto {
+		return nil, fmt.Errorf("license auth codes are only supported for Palo Alto Networks VM-Series")
+	}
+	if config.Panorama != nil && vendor != FirewallVendorPaloAlto {
+		return nil, fmt.Errorf("panorama settings are only supported for Palo Alto Networks VM-Series")
+	}
+	if config.FortiManager != nil && vendor != FirewallVendorFortinet {
+		return nil, fmt.Errorf("fortimanager settings are only supported for Fortinet FortiGate")
+	}
+	if config.SicKey != "" && vendor != FirewallVendorCheckPoint {
+		return nil, fmt.Errorf("sic key is only supported for Check Point")
+	}
+
+	switch vendor {
+	case FirewallVendorPaloAlto:
+		bundle.Files["config/init-cfg.txt"] = renderPaloAltoInitCfg(config)
+		bundle.Files["config/bootstrap.xml"] = renderPaloAltoBootstrapXML(config, bundle)
+		if len(config.LicenseAuthCodes) != 0 {
+			bundle.Files["license/authcodes"] = strings.Join(config.LicenseAuthCodes, "\n") + "\n"
+		}
+	case FirewallVendorFortinet:
+		bundle.UserData = renderFortiGateConfig(config, bundle)
+		bundle.Files["config"] = bundle.UserData
+	case FirewallVendorCheckPoint:
+		if config.SicKey == "" {
+			return nil, fmt.Errorf("sic key is required for Check Point")
+		}
+		if err := validateCheckPointUserDataConfig(config); err != nil {
+			return nil, err
+		}
+		bundle.UserData = renderCheckPointUserData(config, bundle)
+	}
+
+	return bundle, nil
+}
+
+func renderPaloAltoInitCfg(config *FirewallBootstrapConfig) string {
+	var b strings.Builder
+	b.WriteString("type=dhcp-client\n")
+	b.WriteString("ip-address=\n")
+	b.WriteString("default-gateway=\n")
+	b.WriteString("netmask=\n")
+	b.WriteString("ipv6-address=\n")
+	b.WriteString("ipv6-default-gateway=\n")
+	fmt.Fprintf(&b, "hostname=%s\n", config.Hostname)
+	panorama := config.Panorama
+	if panorama == nil {
+		panorama = &PanoramaBootstrapConfig{}
+	}
+	fmt.Fprintf(&b, "vm-auth-key=%s\n", panorama.VmAuthKey)
+	fmt.Fprintf(&b, "panorama-server=%s\n", panorama.Server)
+	fmt.Fprintf(&b, "panorama-server-2=%s\n", panorama.Server2)
+	fmt.Fprintf(&b, "tplname=%s\n", panorama.TemplateStack)
+	fmt.Fprintf(&b, "dgname=%s\n", panorama.DeviceGroup)
+	b.WriteString("dns-primary=\n")
+	b.WriteString("dns-secondary=\n")
+	b.WriteString("op-command-modes=\n")
+	b.WriteString("dhcp-send-hostname=yes\n")
+	b.WriteString("dhcp-send-client-id=yes\n")
+	b.WriteString("dhcp-accept-server-hostname=yes\n")
+	b.WriteString("dhcp-accept-server-domain=yes\n")
+	return b.String()
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+func renderPaloAltoBootstrapXML(config *FirewallBootstrapConfig, bundle *FirewallBootstrapBundle) string {
+	egress := xmlEscape(bundle.EgressInterfaceName)
+	lan := xmlEscape(bundle.LanInterfaceName)
+
+	var b strings.Builder
+	b.WriteString("<?xml version=\"1.0\"?>\n")
+	b.WriteString("<config>\n")
+	if config.AdminPasswordHash != "" || config.SshPublicKey != "" {
+		username := config.AdminUsername
+		if username == "" {
+			username = "admin"
+		}
+		b.WriteString("  <mgt-config>\n    <users>\n")
+		fmt.Fprintf(&b, "      <entry name=\"%s\">\n", xmlEscape(username))
+		if config.AdminPasswordHash != "" {
+			fmt.Fprintf(&b, "        <phash>%s</phash>\n", xmlEscape(config.AdminPasswordHash))
+		}
+		if config.SshPublicKey != "" {
+			fmt.Fprintf(&b, "        <public-key>%s</public-key>\n", xmlEscape(strings.TrimSpace(config.SshPublicKey)))
+		}
+		b.WriteString("        <permissions>\n          <role-based>\n            <superuser>yes</superuser>\n          </role-based>\n        </permissions>\n")
+		b.WriteString("      </entry>\n    </users>\n  </mgt-config>\n")
+	}
+	b.WriteString("  <devices>\n    <entry name=\"localhost.localdomain\">\n")
+	if config.Hostname != "" {
+		fmt.Fprintf(&b, "      <deviceconfig>\n        <system>\n          <hostname>%s</hostname>\n        </system>\n      </deviceconfig>\n", xmlEscape(config.Hostname))
+	}
+	b.WriteString("      <network>\n        <interface>\n          <ethernet>\n")
+	fmt.Fprintf(&b, "            <entry name=\"%s\">\n              <layer3>\n                <dhcp-client>\n                  <create-default-route>yes</create-default-route>\n                </dhcp-client>\n              </layer3>\n            </entry>\n", egress)
+	fmt.Fprintf(&b, "            <entry name=\"%s\">\n              <layer3>\n                <dhcp-client>\n                  <create-default-route>no</create-default-route>\n                </dhcp-client>\n                <interface-management-profile>allow-https</interface-management-profile>\n              </layer3>\n            </entry>\n", lan)
+	b.WriteString("          </ethernet>\n        </interface>\n")
+	b.WriteString("        <profiles>\n          <interface-management-profile>\n            <entry name=\"allow-https\">\n              <https>yes</https>\n            </entry>\n          </interface-management-profile>\n        </profiles>\n")
+	b.WriteString("        <virtual-router>\n          <entry name=\"default\">\n")
+	fmt.Fprintf(&b, "            <interface>\n              <member>%s</member>\n              <member>%s</member>\n            </interface>\n", egress, lan)
+	if len(config.LanRoutes) != 0 {
+		b.WriteString("            <routing-table>\n              <ip>\n                <static-route>\n")
+		for i, route := range config.LanRoutes {
+			fmt.Fprintf(&b, "                  <entry name=\"lan-route-%d\">\n", i+1)
+			fmt.Fprintf(&b, "                    <nexthop>\n                      <ip-address>%s</ip-address>\n                    </nexthop>\n", xmlEscape(config.LanGatewayIP))
+			fmt.Fprintf(&b, "                    <interface>%s</interface>\n", lan)
+			fmt.Fprintf(&b, "                    <destination>%s</destination>\n", xmlEscape(route))
+			b.WriteString("                  </entry>\n")
+		}
+		b.WriteString("                </static-route>\n              </ip>\n            </routing-table>\n")
+	}
+	b.WriteString("          </entry>\n        </virtual-router>\n      </network>\n")
+	b.WriteString("      <vsys>\n        <entry name=\"vsys1\">\n")
+	fmt.Fprintf(&b, "          <import>\n            <network>\n              <interface>\n                <member>%s</member>\n                <member>%s</member>\n              </interface>\n            </network>\n          </import>\n", egress, lan)
+	b.WriteString("          <zone>\n")
+	fmt.Fprintf(&b, "            <entry name=\"WAN\">\n              <network>\n                <layer3>\n                  <member>%s</member>\n                </layer3>\n              </network>\n            </entry>\n", egress)
+	fmt.Fprintf(&b, "            <entry name=\"LAN\">\n              <network>\n                <layer3>\n                  <member>%s</member>\n                </layer3>\n              </network>\n            </entry>\n", lan)
+	b.WriteString("          </zone>\n")
+	if config.AllowAllTraffic {
+		b.WriteString("          <rulebase>\n            <security>\n              <rules>\n                <entry name=\"allow-all\">\n")
+		for _, field := range []string{"to", "from", "source", "destination", "source-user", "category", "application", "service", "hip-profiles"} {
+			fmt.Fprintf(&b, "                  <%[1]s>\n                    <member>any</member>\n                  </%[1]s>\n", field)
+		}
+		b.WriteString("                  <action>allow</action>\n                </entry>\n              </rules>\n            </security>\n          </rulebase>\n")
+	}
+	b.WriteString("        </entry>\n      </vsys>\n")
+	b.WriteString("    </entry>\n  </devices>\n</config>\n")
+	return b.String()
+}
+
+func cidrToAddressAndMask(cidr string) (string, string) {
+	_, ipNet, _ := net.ParseCIDR(cidr)
+	return ipNet.IP.String(), net.IP(ipNet.Mask).String()
+}
+
+func renderFortiGateConfig(config *FirewallBootstrapConfig, bundle *FirewallBootstrapBundle) string {
+	var b strings.Builder
+	if config.Hostname != "" {
+		fmt.Fprintf(&b, "config system global\n    set hostname %q\nend\n", config.Hostname)
+	}
+	if config.AdminPassword != "" || config.SshPublicKey != "" {
+		username := config.AdminUsername
+		if username == "" {
+			username = "admin"
+		}
+		fmt.Fprintf(&b, "config system admin\n    edit %q\n", username)
+		if config.AdminPassword != "" {
+			fmt.Fprintf(&b, "        set password %q\n", config.AdminPassword)
+		}
+		if config.SshPublicKey != "" {
+			fmt.Fprintf(&b, "        set ssh-public-key1 %q\n", strings.TrimSpace(config.SshPublicKey))
+		}
+		b.WriteString("    next\nend\n")
+	}
+	b.WriteString("config system interface\n")
+	fmt.Fprintf(&b, "    edit %q\n        set alias \"egress\"\n        set mode dhcp\n        set allowaccess ping https\n    next\n", bundle.EgressInterfaceName)
+	fmt.Fprintf(&b, "    edit %q\n        set alias \"lan\"\n        set mode dhcp\n        set defaultgw disable\n        set allowaccess ping https\n    next\n", bundle.LanInterfaceName)
+	b.WriteString("end\n")
+	if len(config.LanRoutes) != 0 {
+		b.WriteString("config router static\n")
+		for i, route := range config.LanRoutes {
+			address, mask := cidrToAddressAndMask(route)
+			fmt.Fprintf(&b, "    edit %d\n        set dst %s %s\n        set gateway %s\n        set device %q\n    next\n", i+1, address, mask, config.LanGatewayIP, bundle.LanInterfaceName)
+		}
+		b.WriteString("end\n")
+	}
+	if config.AllowAllTraffic {
+		b.WriteString("config firewall policy\n")
+		fmt.Fprintf(&b, "    edit 1\n        set name \"allow-all-lan\"\n        set srcintf %q\n        set dstintf %q\n        set srcaddr \"all\"\n        set dstaddr \"all\"\n        set action accept\n        set schedule \"always\"\n        set service \"ALL\"\n    next\n", bundle.LanInterfaceName, bundle.LanInterfaceName)
+		b.WriteString("end\n")
+	}
+	if config.FortiManager != nil {
+		b.WriteString("config system central-management\n    set type fortimanager\n")
+		fmt.Fprintf(&b, "    set fmg %q\n", config.FortiManager.IP)
+		if config.FortiManager.SerialNumber != "" {
+			fmt.Fprintf(&b, "    set serial-number %q\n", config.FortiManager.SerialNumber)
+		}
+		b.WriteString("end\n")
+	}
+	return b.String()
+}
+
+var checkPointHostnameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.-]*$`)
+
+// validateCheckPointUserDataConfig rejects values that would break out of the single quoted clish and blink_config
+// arguments of the Check Point user data, or of the '&' separated blink_config string.
+func validateCheckPointUserDataConfig(config *FirewallBootstrapConfig) error {
+	if config.Hostname != "" && !checkPointHostnameRegexp.MatchString(config.Hostname) {
+		return fmt.Errorf("invalid hostname %q: only letters, digits, '.' and '-' are allowed", config.Hostname)
+	}
+	if config.LanGatewayIP != "" && net.ParseIP(config.LanGatewayIP) == nil {
+		return fmt.Errorf("invalid LAN gateway IP %q", config.LanGatewayIP)
+	}
+	blinkConfigValues := map[string]string{
+		"sic key":        config.SicKey,
+		"admin password": config.AdminPassword,
+	}
+	for name, value := range blinkConfigValues {
+		if strings.ContainsAny(value, "'&\r\n") {
+			return fmt.Errorf("%s must not contain single quotes, '&' or line breaks for Check Point", name)
+		}
+	}
+	return nil
+}
+
+func renderCheckPointUserData(config *FirewallBootstrapConfig, bundle *FirewallBootstrapBundle) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/bash\n")
+	if config.Hostname != "" {
+		fmt.Fprintf(&b, "clish -c 'set hostname %s' -s\n", config.Hostname)
+	}
+	b.WriteString("clish -c 'set user admin shell /bin/bash' -s\n")
+	for _, route := range config.LanRoutes {
+		fmt.Fprintf(&b, "clish -c 'set static-route %s nexthop gateway address %s on' -s\n", route, config.LanGatewayIP)
+	}
+	blinkConfig := []string{
+		"upload_info=false",
+		"download_info=false",
+		"install_security_gw=true",
+		"install_ppak=true",
+		"install_security_managment=false",
+		"ipstat_v6=off",
+		"ftw_sic_key=" + config.SicKey,
+	}
+	if config.AdminPassword != "" {
+		blinkConfig = append(blinkConfig, "admin_password_regular="+config.AdminPassword)
+	}
+	fmt.Fprintf(&b, "blink_config -s '%s'\n", strings.Join(blinkConfig, "&"))
+	return b.String()
+}
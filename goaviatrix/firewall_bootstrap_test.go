@@ -0,0 +1,143 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderFirewallBootstrap(t *testing.T) {
+	tt := []struct {
+		Name          string
+		Config        FirewallBootstrapConfig
+		ExpectedErr   string
+		ExpectedFiles []string
+		UserDataHas   string
+	}{
+		{
+			Name: "palo alto",
+			Config: FirewallBootstrapConfig{
+				FirewallImage:    "Palo Alto Networks VM-Series Next-Generation Firewall Bundle 1",
+				LanGatewayIP:     "10.10.0.1",
+				LanRoutes:        []string{"10.0.0.0/8"},
+				LicenseAuthCodes: []string{"I1234567"},
+			},
+			ExpectedFiles: []string{"config/init-cfg.txt", "config/bootstrap.xml", "license/authcodes"},
+		},
+		{
+			Name: "fortigate",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Fortinet FortiGate Next-Generation Firewall",
+				LanGatewayIP:  "10.10.0.1",
+				LanRoutes:     []string{"172.16.0.0/12"},
+				FortiManager:  &FortiManagerBootstrapConfig{IP: "10.20.0.10"},
+			},
+			ExpectedFiles: []string{"config"},
+			UserDataHas:   "set dst 172.16.0.0 255.240.0.0",
+		},
+		{
+			Name: "check point",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Next-Gen Firewall w. Threat Prevention & SandBlast BYOL",
+				SicKey:        "sic-key",
+			},
+			UserDataHas: "ftw_sic_key=sic-key",
+		},
+		{
+			Name:        "unsupported image",
+			Config:      FirewallBootstrapConfig{FirewallImage: "Aviatrix FQDN Egress Filtering"},
+			ExpectedErr: "unsupported firewall image",
+		},
+		{
+			Name: "routes without gateway",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Fortinet FortiGate Next-Generation Firewall",
+				LanRoutes:     []string{"10.0.0.0/8"},
+			},
+			ExpectedErr: "'lan_gateway_ip' is required",
+		},
+		{
+			Name: "auth codes on fortigate",
+			Config: FirewallBootstrapConfig{
+				FirewallImage:    "Fortinet FortiGate Next-Generation Firewall",
+				LicenseAuthCodes: []string{"I1234567"},
+			},
+			ExpectedErr: "only supported for Palo Alto Networks",
+		},
+		{
+			Name: "check point with semicolon in admin password",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Standalone",
+				SicKey:        "sic-key",
+				AdminPassword: "pass;word",
+			},
+			UserDataHas: "blink_config -s 'upload_info=false&download_info=false&install_security_gw=true&install_ppak=true&install_security_managment=false&ipstat_v6=off&ftw_sic_key=sic-key&admin_password_regular=pass;word'",
+		},
+		{
+			Name: "check point with single quote in sic key",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Standalone",
+				SicKey:        "sic'; reboot; '",
+			},
+			ExpectedErr: "sic key must not contain single quotes",
+		},
+		{
+			Name: "check point with ampersand in admin password",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Standalone",
+				SicKey:        "sic-key",
+				AdminPassword: "pass&install_security_managment=true",
+			},
+			ExpectedErr: "admin password must not contain single quotes, '&'",
+		},
+		{
+			Name: "check point with semicolon in hostname",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Standalone",
+				SicKey:        "sic-key",
+				Hostname:      "fw;reboot",
+			},
+			ExpectedErr: "invalid hostname",
+		},
+		{
+			Name: "check point with invalid lan gateway ip",
+			Config: FirewallBootstrapConfig{
+				FirewallImage: "Check Point CloudGuard IaaS Standalone",
+				SicKey:        "sic-key",
+				LanGatewayIP:  "10.10.0.1' on; reboot; '",
+				LanRoutes:     []string{"10.0.0.0/8"},
+			},
+			ExpectedErr: "invalid LAN gateway IP",
+		},
+		{
+			Name:        "check point without sic key",
+			Config:      FirewallBootstrapConfig{FirewallImage: "Check Point CloudGuard IaaS Standalone"},
+			ExpectedErr: "sic key is required",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			bundle, err := RenderFirewallBootstrap(&tc.Config)
+			if tc.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectedErr) {
+					t.Fatalf("test case %q expected an error containing %q, got: %v", tc.Name, tc.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("test case %q expected no error, got %q", tc.Name, err)
+			}
+			if len(bundle.Files) != len(tc.ExpectedFiles) {
+				t.Fatalf("test case %q expected %d files, got %d", tc.Name, len(tc.ExpectedFiles), len(bundle.Files))
+			}
+			for _, path := range tc.ExpectedFiles {
+				if _, ok := bundle.Files[path]; !ok {
+					t.Fatalf("test case %q expected file %q to be rendered", tc.Name, path)
+				}
+			}
+			if !strings.Contains(bundle.UserData, tc.UserDataHas) {
+				t.Fatalf("test case %q expected user_data to contain %q, got %q", tc.Name, tc.UserDataHas, bundle.UserData)
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type FirewallInstance struct {
@@ -240,3 +242,61 @@ func (c *Client) GetFirewallInstanceImages(vpcId string) (*[]FirewallInstanceIma
 
 	return &data.Results.Images, nil
 }
+
+// WaitForFirewallInstanceReady polls the controller until it reports the FireNet health check of the firewall
+// instance as up. The controller only checks the health of firewall instances that are associated with FireNet.
+func (c *Client) WaitForFirewallInstanceReady(ctx context.Context, vpcID, instanceID string, timeout time.Duration) error {
+	probe := func(ctx context.Context) (bool, string, error) {
+		return c.firewallInstanceHealthy(ctx, vpcID, instanceID)
+	}
+
+	err := pollFirewallInstanceReady(ctx, timeout, 15*time.Second, probe)
+	if err != nil {
+		return fmt.Errorf("firewall instance %s never passed the FireNet health check: %v", instanceID, err)
+	}
+	return nil
+}
+
+// firewallInstanceHealthy reports whether the FireNet health check of the firewall instance is up.
+func (c *Client) firewallInstanceHealthy(ctx context.Context, vpcID, instanceID string) (bool, string, error) {
+	health, err := c.GetFireNetFirewallHealth(ctx, vpcID)
+	if err == ErrNotFound {
+		return false, "FireNet not found", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	for _, h := range health {
+		if h.InstanceID != instanceID {
+			continue
+		}
+		if strings.EqualFold(h.HealthCheck, "up") {
+			return true, "", nil
+		}
+		return false, fmt.Sprintf("health check is %q", h.HealthCheck), nil
+	}
+	return false, "no health check status yet", nil
+}
+
+// pollFirewallInstanceReady calls probe every interval until it reports ready, returns an error or the timeout
+// is reached. The reason returned by the last probe is included in the timeout error.
+func pollFirewallInstanceReady(ctx context.Context, timeout, interval time.Duration, probe func(ctx context.Context) (bool, string, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		ready, reason, err := probe(ctx)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("waited %s: %s", timeout, reason)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPollFirewallInstanceReady(t *testing.T) {
+	tt := []struct {
+		Name             string
+		ReadyAfter       int
+		ProbeErr         error
+		Timeout          time.Duration
+		Cancel           bool
+		ExpectedAttempts int
+		ExpectedErr      string
+	}{
+		{
+			Name:             "ready immediately",
+			ReadyAfter:       1,
+			Timeout:          time.Minute,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "ready after polling",
+			ReadyAfter:       3,
+			Timeout:          time.Minute,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "probe error",
+			ReadyAfter:       3,
+			ProbeErr:         errors.New("rest API failed"),
+			Timeout:          time.Minute,
+			ExpectedAttempts: 1,
+			ExpectedErr:      "rest API failed",
+		},
+		{
+			Name:             "timeout",
+			ReadyAfter:       -1,
+			Timeout:          0,
+			ExpectedAttempts: 1,
+			ExpectedErr:      "waited 0s: attempt 1 not ready",
+		},
+		{
+			Name:             "cancelled",
+			ReadyAfter:       -1,
+			Timeout:          time.Minute,
+			Cancel:           true,
+			ExpectedAttempts: 1,
+			ExpectedErr:      context.Canceled.Error(),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			attempts := 0
+			probe := func(ctx context.Context) (bool, string, error) {
+				attempts++
+				if test.Cancel {
+					cancel()
+				}
+				if test.ProbeErr != nil {
+					return false, "", test.ProbeErr
+				}
+				if attempts == test.ReadyAfter {
+					return true, "", nil
+				}
+				return false, fmt.Sprintf("attempt %d not ready", attempts), nil
+			}
+
+			err := pollFirewallInstanceReady(ctx, test.Timeout, time.Millisecond, probe)
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if attempts != test.ExpectedAttempts {
+				t.Errorf("expected %d attempts, got %d", test.ExpectedAttempts, attempts)
+			}
+		})
+	}
+}
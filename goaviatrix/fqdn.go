@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -79,6 +80,37 @@ type FQDNPrivateNetworkingFilteringStatus struct {
 	Rfc1918          []string `json:"rfc_1918"`
 }
 
+type FQDNDiscoveredDomain struct {
+	FQDN     string `json:"fqdn"`
+	Protocol string `json:"protocol"`
+	Port     string `json:"port"`
+	HitCount int    `json:"hit_count"`
+}
+
+type FQDNRuleHit struct {
+	FQDNTag  string `json:"tag_name"`
+	FQDN     string `json:"fqdn"`
+	Protocol string `json:"proto"`
+	Port     string `json:"port"`
+	Verdict  string `json:"verdict"`
+	HitCount int    `json:"hit_count"`
+	LastHit  string `json:"last_hit"`
+}
+
+type FQDNDeniedRequest struct {
+	FQDN     string `json:"fqdn"`
+	Protocol string `json:"proto"`
+	Port     string `json:"port"`
+	SourceIP string `json:"source_ip"`
+	HitCount int    `json:"hit_count"`
+	LastSeen string `json:"last_seen"`
+}
+
+type FQDNGatewayStats struct {
+	RuleHits       []FQDNRuleHit       `json:"rule_hits"`
+	DeniedRequests []FQDNDeniedRequest `json:"denied"`
+}
+
 func (c *Client) CreateFQDN(fqdn *FQDN) error {
 	form := map[string]string{
 		"CID":      c.CID,
@@ -664,3 +696,121 @@ func (c *Client) GetFQDNPrivateNetworkFilteringStatus(ctx context.Context) (*FQD
 	}
 	return &data.Result, nil
 }
+
+func (c *Client) StartFQDNDiscovery(ctx context.Context, gwName string) error {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "start_fqdn_discovery",
+		"gateway_name": gwName,
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "already running") {
+				return nil
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
+}
+
+func (c *Client) StopFQDNDiscovery(ctx context.Context, gwName string) error {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "stop_fqdn_discovery",
+		"gateway_name": gwName,
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "not running") || strings.Contains(reason, "does not exist") {
+				return nil
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
+}
+
+func (c *Client) GetFQDNDiscoveryStatus(ctx context.Context, gwName string) (bool, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "get_fqdn_discovery_status",
+		"gateway_name": gwName,
+	}
+
+	var data struct {
+		Results struct {
+			Running bool `json:"running"`
+		} `json:"results"`
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "does not exist") {
+				return ErrNotFound
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
+	if err != nil {
+		return false, err
+	}
+	return data.Results.Running, nil
+}
+
+func (c *Client) GetFQDNDiscoveredDomains(ctx context.Context, gwName string) ([]FQDNDiscoveredDomain, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "show_fqdn_discovery",
+		"gateway_name": gwName,
+	}
+
+	var data struct {
+		Results []FQDNDiscoveredDomain `json:"results"`
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
+func (c *Client) GetFQDNGatewayStats(ctx context.Context, gwName string, startTime, endTime int64) (*FQDNGatewayStats, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "get_fqdn_filter_stats",
+		"gateway_name": gwName,
+		"start_time":   strconv.FormatInt(startTime, 10),
+		"end_time":     strconv.FormatInt(endTime, 10),
+	}
+
+	var data struct {
+		Results FQDNGatewayStats `json:"results"`
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "does not exist") {
+				return ErrNotFound
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Results, nil
+}
@@ -0,0 +1,218 @@
+package goaviatrix
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FQDNDomainListChunkSize is the number of rules sent to the controller per request
+// when setting the domain names of an FQDN tag.
+const FQDNDomainListChunkSize = 500
+
+var fqdnVerdicts = []string{"Base Policy", "Allow", "Deny"}
+
+// ParseFQDNDomainList parses a domain list with one 'fqdn,protocol,port[,action]' rule per line.
+// Blank lines and text following '#' are ignored. Rules are normalised and duplicates are dropped,
+// keeping the order in which rules first appear.
+func ParseFQDNDomainList(r io.Reader) ([]*Filters, error) {
+	var domainList []*Filters
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) < 3 || len(fields) > 4 {
+			return nil, fmt.Errorf("line %d: expected 'fqdn,protocol,port[,action]', got %q", lineNum, line)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		filter := &Filters{
+			FQDN:     strings.TrimSuffix(strings.ToLower(fields[0]), "."),
+			Protocol: strings.ToLower(fields[1]),
+			Port:     fields[2],
+			Verdict:  "Base Policy",
+		}
+		if filter.FQDN == "" || filter.Protocol == "" || filter.Port == "" {
+			return nil, fmt.Errorf("line %d: fqdn, protocol and port must not be empty", lineNum)
+		}
+		if len(fields) == 4 && fields[3] != "" {
+			verdict := ""
+			for _, v := range fqdnVerdicts {
+				if strings.EqualFold(v, fields[3]) {
+					verdict = v
+				}
+			}
+			if verdict == "" {
+				return nil, fmt.Errorf("line %d: invalid action %q, must be one of 'Base Policy', 'Allow' or 'Deny'", lineNum, fields[3])
+			}
+			filter.Verdict = verdict
+		}
+
+		key := fqdnFilterKey(filter)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		domainList = append(domainList, filter)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return domainList, nil
+}
+
+// ReadFQDNDomainListFile reads and parses a domain list file.
+func ReadFQDNDomainListFile(path string) ([]*Filters, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseFQDNDomainList(f)
+}
+
+// FetchFQDNDomainList downloads and parses a domain list feed. The downloaded content must match the
+// given hex encoded SHA-256 checksum.
+func FetchFQDNDomainList(ctx context.Context, url, checksum string) ([]*Filters, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: 60 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %s", url, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(body)
+	if got := hex.EncodeToString(sum[:]); !strings.EqualFold(got, checksum) {
+		return nil, fmt.Errorf("checksum mismatch for %s: expected %s, got %s", url, checksum, got)
+	}
+
+	return ParseFQDNDomainList(bytes.NewReader(body))
+}
+
+// FQDNDomainListHash returns a checksum of the domain list that does not depend on rule order.
+func FQDNDomainListHash(domainList []*Filters) string {
+	keys := make([]string, 0, len(domainList))
+	for _, filter := range domainList {
+		keys = append(keys, fqdnFilterKey(filter))
+	}
+	sort.Strings(keys)
+
+	sum := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func fqdnFilterKey(filter *Filters) string {
+	return strings.Join([]string{filter.FQDN, filter.Protocol, filter.Port, filter.Verdict}, ",")
+}
+
+// UpdateDomainsInChunks sets the domain names of an FQDN tag. Lists larger than chunkSize are applied
+// incrementally so that requests don't exceed size limits: missing rules are added first and stale rules
+// are removed afterwards, so that a failure never leaves the tag with only part of the new list.
+func (c *Client) UpdateDomainsInChunks(fqdn *FQDN, chunkSize int) error {
+	if chunkSize <= 0 || len(fqdn.DomainList) <= chunkSize {
+		return c.UpdateDomains(fqdn)
+	}
+
+	current, err := c.ListDomains(&FQDN{FQDNTag: fqdn.FQDNTag})
+	if err != nil {
+		return fmt.Errorf("could not list current domain names: %v", err)
+	}
+	toAdd, toDelete := diffFQDNDomainList(current.DomainList, fqdn.DomainList)
+
+	for start := 0; start < len(toAdd); start += chunkSize {
+		end := start + chunkSize
+		if end > len(toAdd) {
+			end = len(toAdd)
+		}
+		err := c.AddFQDNTagRule(&FQDN{
+			FQDNTag:    fqdn.FQDNTag,
+			DomainList: toAdd[start:end],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add domain names %d to %d of %d, no existing domain names were removed: %v", start+1, end, len(toAdd), err)
+		}
+	}
+
+	for start := 0; start < len(toDelete); start += chunkSize {
+		end := start + chunkSize
+		if end > len(toDelete) {
+			end = len(toDelete)
+		}
+		err := c.DeleteFQDNTagRule(&FQDN{
+			FQDNTag:    fqdn.FQDNTag,
+			DomainList: toDelete[start:end],
+		})
+		if err != nil && err != ErrNotFound {
+			return fmt.Errorf("all new domain names were added but failed to remove stale domain names %d to %d of %d: %v", start+1, end, len(toDelete), err)
+		}
+	}
+
+	return nil
+}
+
+// diffFQDNDomainList returns the rules of desired that are missing from current and the rules of current
+// that are not in desired.
+func diffFQDNDomainList(current, desired []*Filters) ([]*Filters, []*Filters) {
+	currentKeys := make(map[string]bool, len(current))
+	for _, filter := range current {
+		currentKeys[fqdnFilterKey(filter)] = true
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, filter := range desired {
+		desiredKeys[fqdnFilterKey(filter)] = true
+	}
+
+	var toAdd, toDelete []*Filters
+	for _, filter := range desired {
+		if !currentKeys[fqdnFilterKey(filter)] {
+			toAdd = append(toAdd, filter)
+		}
+	}
+	for _, filter := range current {
+		if !desiredKeys[fqdnFilterKey(filter)] {
+			toDelete = append(toDelete, filter)
+		}
+	}
+	return toAdd, toDelete
+}
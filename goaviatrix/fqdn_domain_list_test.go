@@ -0,0 +1,107 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseFQDNDomainList(t *testing.T) {
+	tt := []struct {
+		Name        string
+		Input       string
+		Expected    []Filters
+		ExpectedErr string
+	}{
+		{
+			Name: "comments and blank lines",
+			Input: `# egress allowlist
+github.com,tcp,443,Allow
+
+*.amazonaws.com, TCP, 443   # AWS APIs
+`,
+			Expected: []Filters{
+				{FQDN: "github.com", Protocol: "tcp", Port: "443", Verdict: "Allow"},
+				{FQDN: "*.amazonaws.com", Protocol: "tcp", Port: "443", Verdict: "Base Policy"},
+			},
+		},
+		{
+			Name: "normalise and dedupe",
+			Input: `GitHub.com.,tcp,443,allow
+github.com,TCP,443,Allow
+github.com,tcp,443,Deny
+`,
+			Expected: []Filters{
+				{FQDN: "github.com", Protocol: "tcp", Port: "443", Verdict: "Allow"},
+				{FQDN: "github.com", Protocol: "tcp", Port: "443", Verdict: "Deny"},
+			},
+		},
+		{
+			Name:        "missing port",
+			Input:       "github.com,tcp\n",
+			ExpectedErr: "line 1",
+		},
+		{
+			Name:        "invalid action",
+			Input:       "# header\ngithub.com,tcp,443,Drop\n",
+			ExpectedErr: "line 2: invalid action",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			domainList, err := ParseFQDNDomainList(strings.NewReader(test.Input))
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(domainList) != len(test.Expected) {
+				t.Fatalf("expected %d rules, got %d", len(test.Expected), len(domainList))
+			}
+			for i := range domainList {
+				if *domainList[i] != test.Expected[i] {
+					t.Errorf("rule %d: expected %+v, got %+v", i, test.Expected[i], *domainList[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFQDNDomainListHash(t *testing.T) {
+	a := []*Filters{
+		{FQDN: "a.com", Protocol: "tcp", Port: "443", Verdict: "Allow"},
+		{FQDN: "b.com", Protocol: "tcp", Port: "80", Verdict: "Allow"},
+	}
+	b := []*Filters{a[1], a[0]}
+	if FQDNDomainListHash(a) != FQDNDomainListHash(b) {
+		t.Errorf("expected hash to be independent of rule order")
+	}
+	if FQDNDomainListHash(a) == FQDNDomainListHash(a[:1]) {
+		t.Errorf("expected different lists to have different hashes")
+	}
+}
+
+func TestDiffFQDNDomainList(t *testing.T) {
+	a := &Filters{FQDN: "a.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}
+	b := &Filters{FQDN: "b.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}
+	bDeny := &Filters{FQDN: "b.com", Protocol: "tcp", Port: "443", Verdict: "Deny"}
+	c := &Filters{FQDN: "c.com", Protocol: "tcp", Port: "80", Verdict: "Allow"}
+
+	toAdd, toDelete := diffFQDNDomainList([]*Filters{a, b}, []*Filters{a, bDeny, c})
+	if !reflect.DeepEqual(toAdd, []*Filters{bDeny, c}) {
+		t.Errorf("expected to add b.com deny and c.com, got %v", toAdd)
+	}
+	if !reflect.DeepEqual(toDelete, []*Filters{b}) {
+		t.Errorf("expected to delete b.com allow, got %v", toDelete)
+	}
+
+	toAdd, toDelete = diffFQDNDomainList([]*Filters{a}, []*Filters{a})
+	if len(toAdd) != 0 || len(toDelete) != 0 {
+		t.Errorf("expected no changes, got %v and %v", toAdd, toDelete)
+	}
+}
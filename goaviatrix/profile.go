@@ -221,6 +221,10 @@ func (c *Client) GetProfileBasePolicy(profile *Profile) (*Profile, error) {
 }
 
 func (c *Client) ValidateProfileRule(profileRule *ProfileRule) error {
+	return validateProfileRule(profileRule)
+}
+
+func validateProfileRule(profileRule *ProfileRule) error {
 	if profileRule.Action != "allow" && profileRule.Action != "deny" {
 		return fmt.Errorf("valid action is only 'allow' or 'deny'")
 	}
@@ -0,0 +1,222 @@
+package goaviatrix
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var profileTargetHostnameRegex = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+type portRange struct {
+	from, to int
+}
+
+// parseProfilePorts parses a profile rule port, which is a comma separated list of ports and 'from:to' ranges.
+func parseProfilePorts(port string) ([]portRange, error) {
+	var ranges []portRange
+	for _, p := range strings.Split(port, ",") {
+		p = strings.TrimSpace(p)
+		bounds := strings.Split(p, ":")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid port %q", p)
+		}
+		var r portRange
+		var err error
+		if r.from, err = strconv.Atoi(bounds[0]); err != nil {
+			return nil, fmt.Errorf("invalid port %q", p)
+		}
+		r.to = r.from
+		if len(bounds) == 2 {
+			if r.to, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf("invalid port %q", p)
+			}
+		}
+		if r.from < 0 || r.to > 65535 || r.from > r.to {
+			return nil, fmt.Errorf("invalid port range %q, ports must be between 0 and 65535", p)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func validateProfileTarget(target string) error {
+	if strings.Contains(target, "/") {
+		if _, _, err := net.ParseCIDR(target); err != nil {
+			return fmt.Errorf("invalid target CIDR %q", target)
+		}
+		return nil
+	}
+	if net.ParseIP(target) != nil || profileTargetHostnameRegex.MatchString(target) {
+		return nil
+	}
+	return fmt.Errorf("target %q must be a CIDR, an IP address or a hostname", target)
+}
+
+func profileTargetNet(target string) *net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		return ipNet
+	}
+	if ip := net.ParseIP(target); ip != nil {
+		bits := 8 * len(ip.To4())
+		if bits == 0 {
+			bits = 128
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+	return nil
+}
+
+func profileTargetsOverlap(a, b string) bool {
+	netA, netB := profileTargetNet(a), profileTargetNet(b)
+	if netA == nil || netB == nil {
+		return strings.EqualFold(a, b)
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+func profileTargetCovers(a, b string) bool {
+	netA, netB := profileTargetNet(a), profileTargetNet(b)
+	if netA == nil || netB == nil {
+		return strings.EqualFold(a, b)
+	}
+	onesA, _ := netA.Mask.Size()
+	onesB, _ := netB.Mask.Size()
+	return netA.Contains(netB.IP) && onesA <= onesB
+}
+
+func profilePortsOverlap(a, b []portRange) bool {
+	for _, ra := range a {
+		for _, rb := range b {
+			if ra.from <= rb.to && rb.from <= ra.to {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func profilePortsCover(a, b []portRange) bool {
+	for _, rb := range b {
+		covered := false
+		for _, ra := range a {
+			if ra.from <= rb.from && rb.to <= ra.to {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// ProfileRulesOverlap returns true if some traffic matches both rules.
+func ProfileRulesOverlap(a, b ProfileRule) bool {
+	if a.Protocol != b.Protocol && a.Protocol != "all" && b.Protocol != "all" {
+		return false
+	}
+	portsA, errA := parseProfilePorts(a.Port)
+	portsB, errB := parseProfilePorts(b.Port)
+	if errA != nil || errB != nil || !profilePortsOverlap(portsA, portsB) {
+		return false
+	}
+	return profileTargetsOverlap(a.Target, b.Target)
+}
+
+// ProfileRuleCovers returns true if all traffic matching rule b also matches rule a.
+func ProfileRuleCovers(a, b ProfileRule) bool {
+	if a.Protocol != b.Protocol && a.Protocol != "all" {
+		return false
+	}
+	portsA, errA := parseProfilePorts(a.Port)
+	portsB, errB := parseProfilePorts(b.Port)
+	if errA != nil || errB != nil || !profilePortsCover(portsA, portsB) {
+		return false
+	}
+	return profileTargetCovers(a.Target, b.Target)
+}
+
+func (r ProfileRule) String() string {
+	return fmt.Sprintf("%s %s %s port %s", r.Action, r.Protocol, r.Target, r.Port)
+}
+
+// CheckProfileRule checks the action, protocol, port and target of a single policy rule.
+func CheckProfileRule(rule ProfileRule) error {
+	if err := validateProfileRule(&rule); err != nil {
+		return err
+	}
+	if _, err := parseProfilePorts(rule.Port); err != nil {
+		return err
+	}
+	return validateProfileTarget(rule.Target)
+}
+
+// CheckProfilePolicy checks the base rule and all policy rules of a profile. Rules with an invalid action,
+// protocol, port or target are returned as an error. The remaining findings are returned as warnings:
+// an unexpected base rule, duplicate rules, rules that have no effect, and rules that overlap with a rule
+// of the opposite action.
+func CheckProfilePolicy(baseRule string, policy []ProfileRule) ([]string, error) {
+	for i, rule := range policy {
+		if err := CheckProfileRule(rule); err != nil {
+			return nil, fmt.Errorf("policy %d (%s): %v", i, rule, err)
+		}
+	}
+
+	var warnings []string
+	if baseRule != "" && baseRule != "allow_all" && baseRule != "deny_all" {
+		warnings = append(warnings, fmt.Sprintf("base_rule %q should be 'allow_all' or 'deny_all'", baseRule))
+	}
+	for i, rule := range policy {
+		duplicate := false
+		for j := 0; j < i; j++ {
+			if rule == policy[j] {
+				warnings = append(warnings, fmt.Sprintf("policy %d (%s) is a duplicate of policy %d", i, rule, j))
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		if baseRule == rule.Action+"_all" {
+			warnings = append(warnings, fmt.Sprintf("policy %d (%s) has no effect, since base_rule is %q", i, rule, baseRule))
+			continue
+		}
+		for j, other := range policy {
+			if i == j || rule == other {
+				continue
+			}
+			if rule.Action == other.Action && ProfileRuleCovers(other, rule) && (j < i || !ProfileRuleCovers(rule, other)) {
+				warnings = append(warnings, fmt.Sprintf("policy %d (%s) is shadowed by policy %d (%s)", i, rule, j, other))
+				break
+			}
+			if j > i && rule.Action != other.Action && ProfileRulesOverlap(rule, other) {
+				warnings = append(warnings, fmt.Sprintf("policy %d (%s) conflicts with policy %d (%s)", i, rule, j, other))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// CheckProfileConflicts returns a description of each pair of rules in two profiles with opposite
+// actions that match the same traffic. A user attached to both profiles gets contradictory policies.
+func CheckProfileConflicts(a, b *Profile) []string {
+	var conflicts []string
+	if a.BaseRule != "" && b.BaseRule != "" && a.BaseRule != b.BaseRule {
+		conflicts = append(conflicts, fmt.Sprintf("base rule %q of profile %s contradicts base rule %q of profile %s",
+			a.BaseRule, a.Name, b.BaseRule, b.Name))
+	}
+	for _, ruleA := range a.Policy {
+		for _, ruleB := range b.Policy {
+			if ruleA.Action != ruleB.Action && ProfileRulesOverlap(ruleA, ruleB) {
+				conflicts = append(conflicts, fmt.Sprintf("rule (%s) of profile %s contradicts rule (%s) of profile %s",
+					ruleA, a.Name, ruleB, b.Name))
+			}
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,144 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckProfilePolicy(t *testing.T) {
+	tt := []struct {
+		Name             string
+		BaseRule         string
+		Policy           []ProfileRule
+		ExpectedErr      string
+		ExpectedWarnings []string
+	}{
+		{
+			Name:     "valid",
+			BaseRule: "deny_all",
+			Policy: []ProfileRule{
+				{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "443"},
+				{Action: "allow", Protocol: "udp", Target: "dns.example.com", Port: "53"},
+				{Action: "allow", Protocol: "icmp", Target: "10.0.0.1", Port: "0:65535"},
+			},
+		},
+		{
+			Name:        "invalid target",
+			BaseRule:    "deny_all",
+			Policy:      []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/33", Port: "443"}},
+			ExpectedErr: "policy 0 (allow tcp 10.0.0.0/33 port 443): invalid target CIDR",
+		},
+		{
+			Name:        "invalid hostname",
+			BaseRule:    "deny_all",
+			Policy:      []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "dns_.example.com", Port: "443"}},
+			ExpectedErr: "must be a CIDR, an IP address or a hostname",
+		},
+		{
+			Name:     "ipv6 target",
+			BaseRule: "deny_all",
+			Policy:   []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "2001:db8::/32", Port: "443"}},
+		},
+		{
+			Name:        "invalid port range",
+			BaseRule:    "deny_all",
+			Policy:      []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "443:80"}},
+			ExpectedErr: "policy 0 (allow tcp 10.0.0.0/16 port 443:80): invalid port range",
+		},
+		{
+			Name:        "invalid port",
+			BaseRule:    "deny_all",
+			Policy:      []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "https"}},
+			ExpectedErr: "invalid port \"https\"",
+		},
+		{
+			Name:        "port for icmp",
+			BaseRule:    "deny_all",
+			Policy:      []ProfileRule{{Action: "allow", Protocol: "icmp", Target: "10.0.0.0/16", Port: "443"}},
+			ExpectedErr: "port should be '0:65535'",
+		},
+		{
+			Name:     "duplicate",
+			BaseRule: "deny_all",
+			Policy: []ProfileRule{
+				{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "443"},
+				{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "443"},
+			},
+			ExpectedWarnings: []string{"policy 1 (allow tcp 10.0.0.0/16 port 443) is a duplicate of policy 0"},
+		},
+		{
+			Name:             "invalid base rule",
+			BaseRule:         "allow",
+			ExpectedWarnings: []string{"base_rule \"allow\" should be 'allow_all' or 'deny_all'"},
+		},
+		{
+			Name:     "no effect and shadowed",
+			BaseRule: "deny_all",
+			Policy: []ProfileRule{
+				{Action: "deny", Protocol: "tcp", Target: "10.0.0.0/16", Port: "22"},
+				{Action: "allow", Protocol: "tcp", Target: "10.1.0.0/24", Port: "8000:8080"},
+				{Action: "allow", Protocol: "all", Target: "10.1.0.0/16", Port: "0:65535"},
+			},
+			ExpectedWarnings: []string{
+				"policy 0 (deny tcp 10.0.0.0/16 port 22) has no effect",
+				"policy 1 (allow tcp 10.1.0.0/24 port 8000:8080) is shadowed by policy 2",
+			},
+		},
+		{
+			Name: "conflict",
+			Policy: []ProfileRule{
+				{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "443"},
+				{Action: "deny", Protocol: "all", Target: "10.0.1.0/24", Port: "0:65535"},
+			},
+			ExpectedWarnings: []string{"policy 0 (allow tcp 10.0.0.0/16 port 443) conflicts with policy 1"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			warnings, err := CheckProfilePolicy(test.BaseRule, test.Policy)
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(warnings) != len(test.ExpectedWarnings) {
+				t.Fatalf("expected warnings %q, got %q", test.ExpectedWarnings, warnings)
+			}
+			for i := range warnings {
+				if !strings.HasPrefix(warnings[i], test.ExpectedWarnings[i]) {
+					t.Errorf("expected warning starting with %q, got %q", test.ExpectedWarnings[i], warnings[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckProfileConflicts(t *testing.T) {
+	dev := &Profile{
+		Name:     "dev",
+		BaseRule: "deny_all",
+		Policy:   []ProfileRule{{Action: "allow", Protocol: "tcp", Target: "10.0.0.0/16", Port: "22"}},
+	}
+	ops := &Profile{
+		Name:     "ops",
+		BaseRule: "deny_all",
+		Policy:   []ProfileRule{{Action: "allow", Protocol: "all", Target: "10.0.0.0/8", Port: "0:65535"}},
+	}
+	restricted := &Profile{
+		Name:     "restricted",
+		BaseRule: "allow_all",
+		Policy:   []ProfileRule{{Action: "deny", Protocol: "tcp", Target: "10.0.5.0/24", Port: "0:65535"}},
+	}
+
+	if conflicts := CheckProfileConflicts(dev, ops); len(conflicts) != 0 {
+		t.Errorf("expected no conflicts, got %q", conflicts)
+	}
+	if conflicts := CheckProfileConflicts(dev, restricted); len(conflicts) != 2 {
+		t.Errorf("expected base rule and rule conflicts, got %q", conflicts)
+	}
+}
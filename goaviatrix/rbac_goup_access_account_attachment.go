@@ -62,3 +62,21 @@ func (c *Client) DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAt
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// ListRbacGroupAccessAccounts returns the names of all access accounts attached to an RBAC group.
+func (c *Client) ListRbacGroupAccessAccounts(groupName string) ([]string, error) {
+	form := map[string]string{
+		"CID":        c.CID,
+		"action":     "list_access_accounts_in_rbac_group",
+		"group_name": groupName,
+	}
+
+	var data RbacGroupAccessAccountAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.RbacGroupAccessAccountAttachmentList, nil
+}
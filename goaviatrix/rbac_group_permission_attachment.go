@@ -1,6 +1,10 @@
 package goaviatrix
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	log "github.com/sirupsen/logrus"
 )
 
@@ -69,3 +73,64 @@ func (c *Client) DeleteRbacGroupPermissionAttachment(rbacGroupPermissionAttachme
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// ListRbacPermissions returns the catalogue of all RBAC permissions that can be added to an RBAC group.
+func (c *Client) ListRbacPermissions() ([]PermissionAttachmentInfo, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_all_rbac_permissions",
+	}
+
+	var data RbacGroupPermissionAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.RbacGroupPermissionAttachmentList, nil
+}
+
+// ListRbacGroupPermissions returns the names of all permissions of an RBAC group.
+func (c *Client) ListRbacGroupPermissions(groupName string) ([]string, error) {
+	form := map[string]string{
+		"CID":        c.CID,
+		"action":     "list_rbac_group_permissions",
+		"group_name": groupName,
+	}
+
+	var data RbacGroupPermissionAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for _, permission := range data.RbacGroupPermissionAttachmentList {
+		permissions = append(permissions, permission.Name)
+	}
+	return permissions, nil
+}
+
+// ValidateRbacPermissionNames returns an error listing every name that is not in the permission catalogue.
+func ValidateRbacPermissionNames(names []string, catalogue []PermissionAttachmentInfo) error {
+	known := make(map[string]bool)
+	for _, permission := range catalogue {
+		known[permission.Name] = true
+	}
+
+	var unknown []string
+	for _, name := range names {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	return fmt.Errorf("unknown RBAC permissions: %s. Use the aviatrix_rbac_permissions data source to list "+
+		"all valid permissions", strings.Join(unknown, ", "))
+}
@@ -0,0 +1,53 @@
+package goaviatrix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateRbacPermissionNames(t *testing.T) {
+	catalogue := []PermissionAttachmentInfo{
+		{Name: "all_write", DisplayName: "All Write", Type: "all"},
+		{Name: "all_gateway_write", DisplayName: "Gateway Write", Type: "gateway"},
+		{Name: "all_firewall_network_write", DisplayName: "Firewall Network Write", Type: "firewall_network"},
+	}
+
+	tt := []struct {
+		Name        string
+		Names       []string
+		ExpectedErr string
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:  "valid",
+			Names: []string{"all_gateway_write", "all_firewall_network_write"},
+		},
+		{
+			Name:        "typo",
+			Names:       []string{"all_gateway_write", "all_gatway_write"},
+			ExpectedErr: "unknown RBAC permissions: all_gatway_write.",
+		},
+		{
+			Name:        "multiple unknown are sorted",
+			Names:       []string{"b_write", "a_write"},
+			ExpectedErr: "unknown RBAC permissions: a_write, b_write.",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateRbacPermissionNames(test.Names, catalogue)
+			if test.ExpectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+				t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+			}
+		})
+	}
+}
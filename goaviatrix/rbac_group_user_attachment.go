@@ -62,3 +62,21 @@ func (c *Client) DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGrou
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// ListRbacGroupUsers returns the names of all users in an RBAC group.
+func (c *Client) ListRbacGroupUsers(groupName string) ([]string, error) {
+	form := map[string]string{
+		"CID":        c.CID,
+		"action":     "list_users_in_rbac_group",
+		"group_name": groupName,
+	}
+
+	var data RbacGroupUserAttachmentListResp
+
+	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.RbacGroupUserAttachmentList, nil
+}
@@ -1,40 +1,70 @@
 package goaviatrix
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
 )
 
 type SamlEndpoint struct {
-	Action            string   `form:"action,omitempty"`
-	CID               string   `form:"CID,omitempty"`
-	EndPointName      string   `form:"endpoint_name,omitempty" json:"name,omitempty"`
-	IdpMetadataType   string   `form:"idp_metadata_type,omitempty" json:"metadata_type,omitempty"`
-	IdpMetadata       string   `form:"idp_metadata,omitempty" json:"idp_metadata,omitempty"`
-	EntityIdType      string   `json:"entity_id,omitempty"`
-	CustomEntityId    string   `form:"entity_id,omitempty" json:"custom_entityID,omitempty"`
-	MsgTemplate       string   `form:"msgtemplate,omitempty" json:"msgtemplate,omitempty"`
-	MsgTemplateType   string   `json:"msgtemplate_type,omitempty"`
-	ControllerLogin   string   `form:"controller_login,omitempty" json:"controller_login,omitempty"`
-	AccessSetBy       string   `form:"access_ctrl,omitempty" json:"access_ctrl,omitempty"`
-	RbacGroups        string   `form:"groups,omitempty"`
-	RbacGroupsRead    []string `json:"cl_rbac_groups,omitempty"`
-	SignAuthnRequests string   `form:"sign_authn_requests,omitempty"`
+	Action            string             `form:"action,omitempty"`
+	CID               string             `form:"CID,omitempty"`
+	EndPointName      string             `form:"endpoint_name,omitempty" json:"name,omitempty"`
+	IdpMetadataType   string             `form:"idp_metadata_type,omitempty" json:"metadata_type,omitempty"`
+	IdpMetadata       string             `form:"idp_metadata,omitempty" json:"idp_metadata,omitempty"`
+	EntityIdType      string             `json:"entity_id,omitempty"`
+	CustomEntityId    string             `form:"entity_id,omitempty" json:"custom_entityID,omitempty"`
+	MsgTemplate       string             `form:"msgtemplate,omitempty" json:"msgtemplate,omitempty"`
+	MsgTemplateType   string             `json:"msgtemplate_type,omitempty"`
+	ControllerLogin   string             `form:"controller_login,omitempty" json:"controller_login,omitempty"`
+	AccessSetBy       string             `form:"access_ctrl,omitempty" json:"access_ctrl,omitempty"`
+	RbacGroups        string             `form:"groups,omitempty"`
+	RbacGroupsRead    []string           `json:"cl_rbac_groups,omitempty"`
+	SignAuthnRequests string             `form:"sign_authn_requests,omitempty"`
+	GroupAttribute    string             `form:"group_attribute,omitempty"`
+	GroupMapping      string             `form:"group_mapping,omitempty"`
+	GroupMappingList  []SamlGroupMapping `form:"-"`
+}
+
+// SamlGroupMapping maps an IdP group to RBAC groups for controller login or to VPN profiles for VPN login.
+type SamlGroupMapping struct {
+	IdpGroup    string   `json:"idp_group"`
+	RbacGroups  []string `json:"rbac_groups"`
+	VpnProfiles []string `json:"vpn_profiles"`
+}
+
+// SamlIdpMetadataInfo holds details of IdP metadata that are not returned by the controller.
+type SamlIdpMetadataInfo struct {
+	Sha256            string
+	CertificateExpiry time.Time
 }
 
 type SamlEndpointInfo struct {
-	EndPointName      string   `json:"name,omitempty"`
-	IdpMetadataType   string   `json:"metadata_type,omitempty"`
-	IdpMetadata       string   `json:"idp_metadata,omitempty"`
-	IdpMetadataURL    string   `json:"url,omitempty"`
-	EntityIdType      string   `json:"entity_id,omitempty"`
-	CustomEntityId    string   `json:"custom_entityID,omitempty"`
-	MsgTemplate       string   `json:"msgtemplate,omitempty"`
-	MsgTemplateType   string   `json:"msgtemplate_type,omitempty"`
-	ControllerLogin   bool     `json:"controller_login,omitempty"`
-	AccessSetBy       string   `json:"access_ctrl,omitempty"`
-	RbacGroupsRead    []string `json:"cl_rbac_groups,omitempty"`
-	SignAuthnRequests bool     `json:"sign_authn_requests,omitempty"`
+	EndPointName      string             `json:"name,omitempty"`
+	IdpMetadataType   string             `json:"metadata_type,omitempty"`
+	IdpMetadata       string             `json:"idp_metadata,omitempty"`
+	IdpMetadataURL    string             `json:"url,omitempty"`
+	EntityIdType      string             `json:"entity_id,omitempty"`
+	CustomEntityId    string             `json:"custom_entityID,omitempty"`
+	MsgTemplate       string             `json:"msgtemplate,omitempty"`
+	MsgTemplateType   string             `json:"msgtemplate_type,omitempty"`
+	ControllerLogin   bool               `json:"controller_login,omitempty"`
+	AccessSetBy       string             `json:"access_ctrl,omitempty"`
+	RbacGroupsRead    []string           `json:"cl_rbac_groups,omitempty"`
+	SignAuthnRequests bool               `json:"sign_authn_requests,omitempty"`
+	GroupAttribute    string             `json:"group_attribute,omitempty"`
+	GroupMapping      []SamlGroupMapping `json:"group_mapping,omitempty"`
 }
 
 type SamlResp struct {
@@ -43,9 +73,25 @@ type SamlResp struct {
 	Reason  string           `json:"reason"`
 }
 
+func (samlEndpoint *SamlEndpoint) marshalGroupMapping() error {
+	if len(samlEndpoint.GroupMappingList) == 0 {
+		samlEndpoint.GroupMapping = ""
+		return nil
+	}
+	groupMapping, err := json.Marshal(samlEndpoint.GroupMappingList)
+	if err != nil {
+		return fmt.Errorf("could not marshal group mapping: %v", err)
+	}
+	samlEndpoint.GroupMapping = string(groupMapping)
+	return nil
+}
+
 func (c *Client) CreateSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "create_saml_endpoint"
+	if err := samlEndpoint.marshalGroupMapping(); err != nil {
+		return err
+	}
 
 	return c.PostAPI(samlEndpoint.Action, samlEndpoint, BasicCheck)
 }
@@ -81,6 +127,9 @@ func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo,
 func (c *Client) EditSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "edit_saml_endpoint"
+	if err := samlEndpoint.marshalGroupMapping(); err != nil {
+		return err
+	}
 
 	return c.PostAPI("edit_saml_endpoint", samlEndpoint, BasicCheck)
 }
@@ -94,3 +143,90 @@ func (c *Client) DeleteSamlEndpoint(samlEndpoint *SamlEndpoint) error {
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+// FetchSamlIdpMetadata downloads the IdP metadata from the IdP metadata URL.
+func FetchSamlIdpMetadata(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch IdP metadata: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("failed to fetch IdP metadata: GET returned status %s", resp.Status)
+	}
+
+	metadata, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read IdP metadata: %v", err)
+	}
+	return string(metadata), nil
+}
+
+// ParseSamlIdpMetadata returns the SHA-256 checksum of the signing certificates and SSO endpoints in the
+// IdP metadata and the earliest expiry of the X.509 certificates it contains. Formatting and unrelated
+// elements of the metadata do not change the checksum.
+func ParseSamlIdpMetadata(metadata string) (*SamlIdpMetadataInfo, error) {
+	info := &SamlIdpMetadataInfo{}
+	var certs, endpoints []string
+
+	decoder := xml.NewDecoder(strings.NewReader(metadata))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse IdP metadata: %v", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "SingleSignOnService":
+			var binding, location string
+			for _, attr := range start.Attr {
+				switch attr.Name.Local {
+				case "Binding":
+					binding = strings.TrimSpace(attr.Value)
+				case "Location":
+					location = strings.TrimSpace(attr.Value)
+				}
+			}
+			endpoints = append(endpoints, binding+" "+location)
+		case "X509Certificate":
+			var encoded string
+			if err := decoder.DecodeElement(&encoded, &start); err != nil {
+				return nil, fmt.Errorf("could not parse IdP certificate: %v", err)
+			}
+			encoded = strings.Join(strings.Fields(encoded), "")
+			der, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode IdP certificate: %v", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse IdP certificate: %v", err)
+			}
+			certs = append(certs, encoded)
+			if info.CertificateExpiry.IsZero() || cert.NotAfter.Before(info.CertificateExpiry) {
+				info.CertificateExpiry = cert.NotAfter
+			}
+		}
+	}
+
+	sort.Strings(certs)
+	sort.Strings(endpoints)
+	sum := sha256.Sum256([]byte(strings.Join(append(certs, endpoints...), "\n")))
+	info.Sha256 = hex.EncodeToString(sum[:])
+	return info, nil
+}
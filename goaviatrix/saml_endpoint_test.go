@@ -0,0 +1,204 @@
+package goaviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSamlCertificate(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func testSamlMetadata(certs ...string) string {
+	var keyDescriptors string
+	for _, cert := range certs {
+		keyDescriptors += fmt.Sprintf(`
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+        <ds:X509Data>
+          <ds:X509Certificate>
+            %s
+          </ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>`, cert)
+	}
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">%s
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, keyDescriptors)
+}
+
+func TestParseSamlIdpMetadata(t *testing.T) {
+	early := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		Name           string
+		Metadata       string
+		ExpectedExpiry time.Time
+		ExpectedErr    string
+	}{
+		{
+			Name:           "single certificate",
+			Metadata:       testSamlMetadata(testSamlCertificate(t, late)),
+			ExpectedExpiry: late,
+		},
+		{
+			Name:           "certificate rollover",
+			Metadata:       testSamlMetadata(testSamlCertificate(t, late), testSamlCertificate(t, early)),
+			ExpectedExpiry: early,
+		},
+		{
+			Name:     "no certificate",
+			Metadata: testSamlMetadata(),
+		},
+		{
+			Name:        "invalid certificate",
+			Metadata:    testSamlMetadata("bm90IGEgY2VydGlmaWNhdGU="),
+			ExpectedErr: "could not parse IdP certificate",
+		},
+		{
+			Name:        "invalid xml",
+			Metadata:    "<md:EntityDescriptor>",
+			ExpectedErr: "could not parse IdP metadata",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			info, err := ParseSamlIdpMetadata(test.Metadata)
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(info.Sha256) != 64 {
+				t.Errorf("expected a SHA-256 checksum, got %q", info.Sha256)
+			}
+			if !info.CertificateExpiry.Equal(test.ExpectedExpiry) {
+				t.Errorf("expected certificate expiry %v, got %v", test.ExpectedExpiry, info.CertificateExpiry)
+			}
+		})
+	}
+}
+
+func TestParseSamlIdpMetadataChecksum(t *testing.T) {
+	cert := testSamlCertificate(t, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC))
+	otherCert := testSamlCertificate(t, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC))
+	metadata := testSamlMetadata(cert)
+
+	tt := []struct {
+		Name            string
+		Metadata        string
+		ExpectedChanged bool
+	}{
+		{
+			Name:     "whitespace",
+			Metadata: strings.ReplaceAll(strings.ReplaceAll(metadata, "\n", "\r\n"), "  ", "\t"),
+		},
+		{
+			Name:     "unrelated element",
+			Metadata: strings.Replace(metadata, "</md:EntityDescriptor>", "<md:Organization><md:OrganizationName>Example</md:OrganizationName></md:Organization></md:EntityDescriptor>", 1),
+		},
+		{
+			Name:     "unrelated attribute",
+			Metadata: strings.Replace(metadata, `entityID="https://idp.example.com"`, `entityID="https://idp.example.com" validUntil="2030-01-01T00:00:00Z"`, 1),
+		},
+		{
+			Name:            "certificate",
+			Metadata:        testSamlMetadata(otherCert),
+			ExpectedChanged: true,
+		},
+		{
+			Name:            "added certificate",
+			Metadata:        testSamlMetadata(cert, otherCert),
+			ExpectedChanged: true,
+		},
+		{
+			Name:            "sso endpoint",
+			Metadata:        strings.Replace(metadata, "https://idp.example.com/sso", "https://idp.example.com/sso2", 1),
+			ExpectedChanged: true,
+		},
+	}
+
+	info, err := ParseSamlIdpMetadata(metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			changedInfo, err := ParseSamlIdpMetadata(test.Metadata)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed := changedInfo.Sha256 != info.Sha256; changed != test.ExpectedChanged {
+				t.Errorf("expected checksum changed to be %t, got %t", test.ExpectedChanged, changed)
+			}
+		})
+	}
+}
+
+func TestMarshalSamlGroupMapping(t *testing.T) {
+	tt := []struct {
+		Name                 string
+		GroupMappingList     []SamlGroupMapping
+		ExpectedGroupMapping string
+	}{
+		{
+			Name: "no mapping",
+		},
+		{
+			Name:             "empty mapping",
+			GroupMappingList: []SamlGroupMapping{},
+		},
+		{
+			Name:                 "mapping",
+			GroupMappingList:     []SamlGroupMapping{{IdpGroup: "admins", RbacGroups: []string{"admin"}}},
+			ExpectedGroupMapping: `[{"idp_group":"admins","rbac_groups":["admin"],"vpn_profiles":null}]`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			samlEndpoint := &SamlEndpoint{GroupMapping: "stale", GroupMappingList: test.GroupMappingList}
+			if err := samlEndpoint.marshalGroupMapping(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if samlEndpoint.GroupMapping != test.ExpectedGroupMapping {
+				t.Errorf("expected group mapping %q, got %q", test.ExpectedGroupMapping, samlEndpoint.GroupMapping)
+			}
+		})
+	}
+}
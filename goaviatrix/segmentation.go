@@ -1,6 +1,9 @@
 package goaviatrix
 
-import "strings"
+import (
+	"context"
+	"strings"
+)
 
 type SegmentationSecurityDomain struct {
 	DomainName string
@@ -63,6 +66,28 @@ func (c *Client) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomai
 	return domain, nil
 }
 
+func (c *Client) GetSegmentationSecurityDomainNames(ctx context.Context) ([]string, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_multi_cloud_security_domain_names",
+	}
+
+	type Resp struct {
+		Return  bool     `json:"return"`
+		Results []string `json:"results"`
+		Reason  string   `json:"reason"`
+	}
+
+	var data Resp
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Results, nil
+}
+
 func (c *Client) CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
 	action := "connect_multi_cloud_security_domains"
 	data := map[string]interface{}{
@@ -118,6 +143,34 @@ func (c *Client) GetSegmentationSecurityDomainConnectionPolicy(policy *Segmentat
 	return policy, nil
 }
 
+func (c *Client) GetSegmentationSecurityDomainConnectedDomains(ctx context.Context, domainName string) ([]string, error) {
+	form := map[string]string{
+		"CID":         c.CID,
+		"action":      "list_multi_cloud_security_domain_connection_policy",
+		"domain_name": domainName,
+	}
+
+	type Result struct {
+		ConnectedDomains    []string `json:"connected_domains"`
+		NotConnectedDomains []string `json:"not_connected_domains"`
+	}
+
+	type Resp struct {
+		Return  bool   `json:"return"`
+		Results Result `json:"results"`
+		Reason  string `json:"reason"`
+	}
+
+	var data Resp
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Results.ConnectedDomains, nil
+}
+
 func (c *Client) CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
 	action := "associate_attachment_to_multi_cloud_security_domain"
 	data := map[string]interface{}{
@@ -2,6 +2,8 @@ package goaviatrix
 
 import (
 	"context"
+	"fmt"
+	"strings"
 )
 
 type VendorInfo struct {
@@ -37,6 +39,11 @@ type FirewallManager struct {
 	Synchronize   bool
 }
 
+type FireNetFirewallRouteStatus struct {
+	Routes []string `json:"routes"`
+	InSync bool     `json:"in_sync"`
+}
+
 func (c *Client) EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error {
 	form := map[string]string{
 		"CID":             c.CID,
@@ -121,3 +128,37 @@ func (c *Client) EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo *VendorI
 
 	return c.PostFileAPI(params, files, BasicCheck)
 }
+
+// GetFireNetFirewallRouteStatus returns the routes last programmed by the controller on a firewall instance,
+// or on the firewall manager of a FireNet gateway when instanceID is empty, and whether they are still in sync.
+func (c *Client) GetFireNetFirewallRouteStatus(ctx context.Context, vpcID, instanceID, gwName string) (*FireNetFirewallRouteStatus, error) {
+	params := map[string]string{
+		"action": "get_firenet_firewall_vendor_route_status",
+		"CID":    c.CID,
+		"vpc_id": vpcID,
+	}
+	if instanceID != "" {
+		params["firewall_id"] = instanceID
+	} else {
+		params["gw_name"] = gwName
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "not found") || strings.Contains(reason, "not configured") {
+				return ErrNotFound
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	var data struct {
+		Results FireNetFirewallRouteStatus `json:"results"`
+	}
+	err := c.GetAPIContext(ctx, &data, params["action"], params, checkFunc)
+	if err != nil {
+		return nil, err
+	}
+	return &data.Results, nil
+}
@@ -0,0 +1,60 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// VPNSession holds the details of a connected VPN user
+type VPNSession struct {
+	UserName    string `json:"username"`
+	GwName      string `json:"lb_or_gateway_name"`
+	PublicIP    string `json:"public_ip"`
+	VirtualIP   string `json:"virtual_ip"`
+	Profile     string `json:"profile"`
+	ConnectTime string `json:"connected_since"`
+	BytesIn     int    `json:"bytes_received"`
+	BytesOut    int    `json:"bytes_sent"`
+}
+
+// ListVPNSessions returns the sessions of all currently connected VPN users.
+func (c *Client) ListVPNSessions(ctx context.Context) ([]VPNSession, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_active_vpn_users",
+	}
+
+	var data struct {
+		Results []VPNSession `json:"results"`
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
+// DisconnectVPNSession disconnects a VPN user from the given gateway or load balancer.
+func (c *Client) DisconnectVPNSession(ctx context.Context, session *VPNSession) error {
+	form := map[string]string{
+		"CID":                c.CID,
+		"action":             "disconnect_vpn_user",
+		"lb_or_gateway_name": session.GwName,
+		"username":           session.UserName,
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			// The user may have disconnected by themselves in the meantime
+			if strings.Contains(reason, "not connected") {
+				return nil
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
+}
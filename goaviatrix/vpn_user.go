@@ -1,8 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 )
 
@@ -111,3 +115,107 @@ func (c *Client) DeleteVPNUser(vpnUser *VPNUser) error {
 
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
+
+func (c *Client) ListVPNUsers(ctx context.Context) ([]VPNUser, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_vpn_users",
+	}
+
+	var data struct {
+		Results []VPNUser `json:"results"`
+	}
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}
+
+var vpnUserCSVColumns = []string{"user_name", "user_email", "vpc_id", "gw_name", "dns_name", "saml_endpoint", "profiles"}
+
+// ParseVPNUsersCSV parses a CSV file of VPN users. The first row is a header naming the columns,
+// which are any of user_name (required), user_email, vpc_id, gw_name, dns_name, saml_endpoint and
+// profiles. Profiles are separated by ';'. Rows starting with '#' are ignored.
+func ParseVPNUsersCSV(r io.Reader) ([]VPNUser, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if !Contains(vpnUserCSVColumns, column) {
+			return nil, fmt.Errorf("unknown column %q, valid columns are: %s", column, strings.Join(vpnUserCSVColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["user_name"]; !ok {
+		return nil, fmt.Errorf("missing required column \"user_name\"")
+	}
+
+	get := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var users []VPNUser
+	seen := make(map[string]bool)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		user := VPNUser{
+			UserName:     get(record, "user_name"),
+			UserEmail:    get(record, "user_email"),
+			VpcID:        get(record, "vpc_id"),
+			GwName:       get(record, "gw_name"),
+			DnsName:      get(record, "dns_name"),
+			SamlEndpoint: get(record, "saml_endpoint"),
+		}
+		if user.UserName == "" {
+			return nil, fmt.Errorf("line %d: user_name must not be empty", line)
+		}
+		if seen[user.UserName] {
+			return nil, fmt.Errorf("line %d: duplicate user_name %q", line, user.UserName)
+		}
+		seen[user.UserName] = true
+		user.DnsEnabled = user.DnsName != ""
+		for _, profile := range strings.Split(get(record, "profiles"), ";") {
+			if profile = strings.TrimSpace(profile); profile != "" {
+				user.Profiles = append(user.Profiles, profile)
+			}
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// ReadVPNUsersFile reads and parses a CSV file of VPN users.
+func ReadVPNUsersFile(path string) ([]VPNUser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseVPNUsersCSV(f)
+}
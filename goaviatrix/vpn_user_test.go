@@ -0,0 +1,69 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseVPNUsersCSV(t *testing.T) {
+	tt := []struct {
+		Name        string
+		Input       string
+		Expected    []VPNUser
+		ExpectedErr string
+	}{
+		{
+			Name: "users",
+			Input: `user_name,user_email,vpc_id,gw_name,profiles
+# contractors
+alice,alice@example.com,vpc-1,elb-1,dev;ops
+bob,,vpc-1,elb-1,
+`,
+			Expected: []VPNUser{
+				{UserName: "alice", UserEmail: "alice@example.com", VpcID: "vpc-1", GwName: "elb-1", Profiles: []string{"dev", "ops"}},
+				{UserName: "bob", VpcID: "vpc-1", GwName: "elb-1"},
+			},
+		},
+		{
+			Name:  "dns",
+			Input: "dns_name,user_name\nvpn.example.com,carol\n",
+			Expected: []VPNUser{
+				{UserName: "carol", DnsName: "vpn.example.com", DnsEnabled: true},
+			},
+		},
+		{
+			Name:        "unknown column",
+			Input:       "user_name,email\n",
+			ExpectedErr: "unknown column \"email\"",
+		},
+		{
+			Name:        "missing user_name column",
+			Input:       "user_email\nalice@example.com\n",
+			ExpectedErr: "missing required column",
+		},
+		{
+			Name:        "duplicate user",
+			Input:       "user_name,vpc_id,gw_name\nalice,vpc-1,elb-1\nalice,vpc-2,elb-2\n",
+			ExpectedErr: "line 3: duplicate user_name",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			users, err := ParseVPNUsersCSV(strings.NewReader(test.Input))
+			if test.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(users, test.Expected) {
+				t.Errorf("expected %+v, got %+v", test.Expected, users)
+			}
+		})
+	}
+}
@@ -76,16 +76,26 @@ SetEnv EDGE_CSP_TEMPLATE_UUID $(terraform output -raw EDGE_CSP_TEMPLATE_UUID)
 
 SetEnv SKIP_CID_EXPIRY "yes"
 SetEnv SKIP_DATA_ACCOUNT "no"
+SetEnv SKIP_DATA_ACCOUNT_AUDIT "no"
+SetEnv SKIP_DATA_AWS_TGW_ROUTE_TABLES "no"
 SetEnv SKIP_DATA_CALLER_IDENTITY "no"
+SetEnv SKIP_DATA_CLOUDN_DEVICES "no"
+SetEnv SKIP_DATA_CONNECTION_STATUS "no"
 SetEnv SKIP_DATA_DEVICE_INTERFACES "no"
+SetEnv SKIP_DATA_EDGE_GATEWAYS "no"
 SetEnv SKIP_DATA_FIRENET "no"
 SetEnv SKIP_DATA_FIRENET_FIREWALL_MANAGER "no"
+SetEnv SKIP_DATA_FIRENET_HEALTH "no"
 SetEnv SKIP_DATA_FIRENET_VENDOR_INTEGRATION "no"
 SetEnv SKIP_DATA_FIREWALL "no"
+SetEnv SKIP_DATA_FIREWALL_INSTANCE_BOOTSTRAP "no"
 SetEnv SKIP_DATA_FIREWALL_INSTANCE_IMAGES "no"
+SetEnv SKIP_DATA_FQDN_DISCOVERED_DOMAINS "no"
+SetEnv SKIP_DATA_FQDN_STATS "no"
 SetEnv SKIP_DATA_GATEWAY "no"
 SetEnv SKIP_DATA_GATEWAY_IMAGE "no"
 SetEnv SKIP_DATA_NETWORK_DOMAINS "no"
+SetEnv SKIP_DATA_RBAC_PERMISSIONS "no"
 SetEnv SKIP_DATA_SPOKE_GATEWAY "no"
 SetEnv SKIP_DATA_SPOKE_GATEWAY_AWS "no"
 SetEnv SKIP_DATA_SPOKE_GATEWAY_AZURE "no"
@@ -99,6 +109,7 @@ SetEnv SKIP_DATA_TRANSIT_GATEWAY_GCP "no"
 SetEnv SKIP_DATA_TRANSIT_GATEWAYS "no"
 SetEnv SKIP_DATA_VPC "no"
 SetEnv SKIP_DATA_VPC_TRACKER "no"
+SetEnv SKIP_DATA_VPN_SESSIONS "no"
 SetEnv SKIP_ACCOUNT "no"
 SetEnv SKIP_ACCOUNT_AWS "no"
 SetEnv SKIP_ACCOUNT_AZURE "no"
@@ -120,6 +131,9 @@ SetEnv SKIP_AWS_TGW_CONNECT "no"
 SetEnv SKIP_AWS_TGW_CONNECT_PEER "no"
 SetEnv SKIP_AWS_TGW_DIRECTCONNECT "no"
 SetEnv SKIP_AWS_TGW_INTRA_DOMAIN_INSPECTION "no"
+SetEnv SKIP_AWS_TGW_MULTICAST_DOMAIN "no"
+SetEnv SKIP_AWS_TGW_MULTICAST_DOMAIN_ASSOCIATION "no"
+SetEnv SKIP_AWS_TGW_MULTICAST_GROUP_MEMBER "no"
 SetEnv SKIP_AWS_TGW_NETWORK_DOMAIN "no"
 SetEnv SKIP_AWS_TGW_PEERING "no"
 SetEnv SKIP_AWS_TGW_PEERING_DOMAIN_CONN "no"
@@ -134,6 +148,7 @@ SetEnv SKIP_AZURE_VNG_CONN "no"
 SetEnv SKIP_CENTRALIZED_TRANSIT_FIRENET "no"
 SetEnv SKIP_CLOUDN_REGISTRATION "no"
 SetEnv SKIP_CLOUDN_TRANSIT_GATEWAY_ATTACHMENT "no"
+SetEnv SKIP_CLOUDN_UPGRADE "no"
 SetEnv SKIP_CLOUDWATCH_AGENT "no"
 SetEnv SKIP_CONTROLLER_BGP_MAX_AS_LIMIT_CONFIG "no"
 SetEnv SKIP_CONTROLLER_CERT_DOMAIN_CONFIG "no"
@@ -152,11 +167,16 @@ SetEnv SKIP_DISTRIBUTED_FIREWALLING_INTRA_VPC "no"
 SetEnv SKIP_DISTRIBUTED_FIREWALLING_POLICY_LIST "no"
 SetEnv SKIP_EDGE_CAAG "no"
 SetEnv SKIP_EDGE_CSP "no"
+SetEnv SKIP_EDGE_CSP_COMPUTE_NODE "no"
+SetEnv SKIP_EDGE_CSP_PROJECT "no"
 SetEnv SKIP_EDGE_SPOKE "no"
 SetEnv SKIP_EDGE_SPOKE_EXTERNAL_DEVICE_CONN "no"
+SetEnv SKIP_EDGE_SPOKE_HA "no"
 SetEnv SKIP_EDGE_SPOKE_TRANSIT_ATTACHMENT "no"
+SetEnv SKIP_EDGE_ZTP_BUNDLE "no"
 SetEnv SKIP_FILEBEAT_FORWARDER "no"
 SetEnv SKIP_FIRENET "no"
+SetEnv SKIP_FIRENET_FIREWALL_ROUTE_SYNC "no"
 SetEnv SKIP_FIREWALL "no"
 SetEnv SKIP_FIREWALL_INSTANCE "no"
 SetEnv SKIP_FIREWALL_INSTANCE_ASSOCIATION "no"
@@ -166,6 +186,7 @@ SetEnv SKIP_FIREWALL_MANAGEMENT_ACCESS_AZURE "no"
 SetEnv SKIP_FIREWALL_POLICY "no"
 SetEnv SKIP_FIREWALL_TAG "no"
 SetEnv SKIP_FQDN "no"
+SetEnv SKIP_FQDN_DISCOVERY "no"
 SetEnv SKIP_FQDN_GLOBAL_CONFIG "no"
 SetEnv SKIP_FQDN_PASS_THROUGH "no"
 SetEnv SKIP_FQDN_TAG_RULE "no"
@@ -199,6 +220,7 @@ SetEnv SKIP_S2C_CA_CERT_TAG "no"
 SetEnv SKIP_SEGMENTATION_NETWORK_DOMAIN "no"
 SetEnv SKIP_SEGMENTATION_NETWORK_DOMAIN_ASSOCIATION "no"
 SetEnv SKIP_SEGMENTATION_NETWORK_DOMAIN_CONNECTION_POLICY "no"
+SetEnv SKIP_SEGMENTATION_POLICY_MATRIX "no"
 SetEnv SKIP_SEGMENTATION_SECURITY_DOMAIN "no"
 SetEnv SKIP_SEGMENTATION_SECURITY_DOMAIN_ASSOCIATION "no"
 SetEnv SKIP_SEGMENTATION_SECURITY_DOMAIN_CONNECTION_POLICY "no"
@@ -238,5 +260,7 @@ SetEnv SKIP_VGW_CONN "no"
 SetEnv SKIP_VPC "no"
 SetEnv SKIP_VPN_CERT_DOWNLOAD "no"
 SetEnv SKIP_VPN_PROFILE "no"
+SetEnv SKIP_VPN_SESSION_DISCONNECT "no"
 SetEnv SKIP_VPN_USER "no"
 SetEnv SKIP_VPN_USER_ACCELERATOR "no"
+SetEnv SKIP_VPN_USERS "no"